@@ -0,0 +1,200 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/snowmerak/gidle/pkg/gidle"
+	"gopkg.in/yaml.v3"
+)
+
+// ConfigFileNames are the project configuration files gidle looks for, in order.
+var ConfigFileNames = []string{"gidle.yaml", "gidle.yml", "gidle.json"}
+
+type LanguageConfig struct {
+	Out     string                 `yaml:"out" json:"out"`
+	Options gidle.GeneratorOptions `yaml:"options" json:"options"`
+}
+
+type Config struct {
+	Inputs    []string                  `yaml:"inputs" json:"inputs"`
+	Languages map[string]LanguageConfig `yaml:"languages" json:"languages"`
+
+	// dir is the directory of the configuration file. Inputs and outputs are relative to it.
+	dir string
+}
+
+type ConfigInput struct {
+	// Path is the schema file path.
+	Path string
+	// Rel is Path relative to the static prefix of the glob that matched it.
+	Rel string
+}
+
+// FindConfig returns the path of the first configuration file in dir.
+func FindConfig(dir string) (string, error) {
+	for _, name := range ConfigFileNames {
+		p := filepath.Join(dir, name)
+		if _, err := os.Stat(p); err == nil {
+			return p, nil
+		} else if !errors.Is(err, fs.ErrNotExist) {
+			return "", err
+		}
+	}
+
+	return "", errors.New("no " + strings.Join(ConfigFileNames, ", ") + " in " + dir)
+}
+
+func LoadConfig(configPath string) (*Config, error) {
+	data, err := os.ReadFile(configPath)
+	if err != nil {
+		return nil, err
+	}
+
+	config := &Config{dir: filepath.Dir(configPath)}
+	if filepath.Ext(configPath) == ".json" {
+		err = json.Unmarshal(data, config)
+	} else {
+		err = yaml.Unmarshal(data, config)
+	}
+	if err != nil {
+		return nil, errors.New(configPath + ": " + err.Error())
+	}
+
+	if len(config.Inputs) == 0 {
+		return nil, errors.New(configPath + ": no inputs")
+	}
+	if len(config.Languages) == 0 {
+		return nil, errors.New(configPath + ": no languages")
+	}
+	for lang, lc := range config.Languages {
+		if lc.Out == "" {
+			return nil, errors.New(configPath + ": no out directory for " + lang)
+		}
+	}
+
+	return config, nil
+}
+
+// LanguageNames returns the configured languages in a stable order.
+func (c *Config) LanguageNames() []string {
+	names := make([]string, 0, len(c.Languages))
+	for lang := range c.Languages {
+		names = append(names, lang)
+	}
+	sort.Strings(names)
+
+	return names
+}
+
+// InputFiles expands the input globs. `**` matches any number of directories.
+func (c *Config) InputFiles() ([]ConfigInput, error) {
+	seen := make(map[string]bool)
+	var inputs []ConfigInput
+	for _, pattern := range c.Inputs {
+		pattern = path.Clean(filepath.ToSlash(pattern))
+		base := globBase(pattern)
+		root := filepath.Join(c.dir, filepath.FromSlash(base))
+
+		matched := false
+		err := filepath.WalkDir(root, func(p string, d fs.DirEntry, err error) error {
+			if err != nil {
+				return err
+			}
+			if d.IsDir() {
+				return nil
+			}
+
+			rel, err := filepath.Rel(c.dir, p)
+			if err != nil {
+				return err
+			}
+			if !matchGlob(strings.Split(pattern, "/"), strings.Split(filepath.ToSlash(rel), "/")) {
+				return nil
+			}
+			matched = true
+
+			if seen[p] {
+				return nil
+			}
+			seen[p] = true
+
+			relBase, err := filepath.Rel(root, p)
+			if err != nil {
+				return err
+			}
+			inputs = append(inputs, ConfigInput{Path: p, Rel: relBase})
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+		if !matched {
+			return nil, errors.New("no files match " + pattern)
+		}
+	}
+
+	return inputs, nil
+}
+
+// OutputPath returns where the lang output of input, whose schema is values, is written.
+// Go and Python outputs are placed under the package path instead of the input layout,
+// because their imports name the package of the imported schema.
+func (c *Config) OutputPath(lang string, input ConfigInput, values *gidle.Grammar) string {
+	rel := strings.TrimSuffix(input.Rel, filepath.Ext(input.Rel))
+	switch lang {
+	case gidle.LanguageGo, gidle.LanguagePython:
+		rel = filepath.Join(filepath.Join(values.Package.Names...), filepath.Base(rel))
+	}
+	return filepath.Join(c.dir, c.Languages[lang].Out, rel+gidle.LanguageExtension(lang))
+}
+
+// globBase returns the leading directories of pattern that contain no wildcards.
+func globBase(pattern string) string {
+	segments := strings.Split(pattern, "/")
+	base := make([]string, 0, len(segments))
+	for _, s := range segments[:len(segments)-1] {
+		if strings.ContainsAny(s, "*?[\\") {
+			break
+		}
+		base = append(base, s)
+	}
+
+	if len(base) == 0 {
+		return "."
+	}
+
+	return path.Join(base...)
+}
+
+func matchGlob(pattern, name []string) bool {
+	if len(pattern) == 0 {
+		return len(name) == 0
+	}
+
+	if pattern[0] == "**" {
+		for i := 0; i <= len(name); i++ {
+			if matchGlob(pattern[1:], name[i:]) {
+				return true
+			}
+		}
+		return false
+	}
+
+	if len(name) == 0 {
+		return false
+	}
+
+	ok, err := path.Match(pattern[0], name[0])
+	if err != nil || !ok {
+		return false
+	}
+
+	return matchGlob(pattern[1:], name[1:])
+}
@@ -0,0 +1,204 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/alecthomas/participle/v2"
+	"github.com/alecthomas/participle/v2/lexer"
+	"github.com/snowmerak/gidle/pkg/gidle"
+)
+
+type DiagnosticKind string
+
+const (
+	DiagnosticUsage    DiagnosticKind = "usage"
+	DiagnosticParse    DiagnosticKind = "parse"
+	DiagnosticSemantic DiagnosticKind = "semantic"
+	DiagnosticIO       DiagnosticKind = "io"
+	DiagnosticGenerate DiagnosticKind = "generate"
+)
+
+const (
+	ExitOK = 0
+	// ExitCheckFailed reports a check that ran but did not pass, e.g. unformatted files for `fmt -check`,
+	// breaking changes for `diff` or invalid documents for `validate`.
+	ExitCheckFailed = 1
+	ExitUsage       = 2
+	ExitParse       = 3
+	ExitSemantic    = 4
+	ExitIO          = 5
+	ExitGenerate    = 6
+)
+
+const (
+	DiagnosticFormatText = "text"
+	DiagnosticFormatJSON = "json"
+)
+
+func (k DiagnosticKind) ExitCode() int {
+	switch k {
+	case DiagnosticUsage:
+		return ExitUsage
+	case DiagnosticParse:
+		return ExitParse
+	case DiagnosticSemantic:
+		return ExitSemantic
+	case DiagnosticIO:
+		return ExitIO
+	default:
+		return ExitGenerate
+	}
+}
+
+type Diagnostic struct {
+	Kind    DiagnosticKind `json:"kind"`
+	File    string         `json:"file,omitempty"`
+	Line    int            `json:"line,omitempty"`
+	Column  int            `json:"column,omitempty"`
+	Message string         `json:"message"`
+}
+
+func NewDiagnostic(kind DiagnosticKind, pos lexer.Position, message string) *Diagnostic {
+	return &Diagnostic{
+		Kind:    kind,
+		File:    pos.Filename,
+		Line:    pos.Line,
+		Column:  pos.Column,
+		Message: message,
+	}
+}
+
+// DiagnosticsFromError converts err into diagnostics, keeping the source positions of
+// participle parse errors, gidle.CheckErrors and gidle.CheckError. A single CheckError,
+// e.g. of a generator, and other errors are reported as kind.
+func DiagnosticsFromError(kind DiagnosticKind, err error) []*Diagnostic {
+	var checkErrors gidle.CheckErrors
+	if errors.As(err, &checkErrors) {
+		diagnostics := make([]*Diagnostic, 0, len(checkErrors))
+		for _, e := range checkErrors {
+			diagnostics = append(diagnostics, NewDiagnostic(DiagnosticSemantic, e.Pos, e.Message))
+		}
+		return diagnostics
+	}
+
+	var parseError participle.Error
+	if errors.As(err, &parseError) {
+		return []*Diagnostic{NewDiagnostic(DiagnosticParse, parseError.Position(), parseError.Message())}
+	}
+
+	if joined, ok := err.(interface{ Unwrap() []error }); ok {
+		var diagnostics []*Diagnostic
+		for _, e := range joined.Unwrap() {
+			diagnostics = append(diagnostics, DiagnosticsFromError(kind, e)...)
+		}
+		return diagnostics
+	}
+
+	var checkError *gidle.CheckError
+	if errors.As(err, &checkError) {
+		return []*Diagnostic{NewDiagnostic(kind, checkError.Pos, checkError.Message)}
+	}
+
+	return []*Diagnostic{{Kind: kind, Message: err.Error()}}
+}
+
+type DiagnosticReporter struct {
+	writer  io.Writer
+	format  string
+	sources map[string][]byte
+}
+
+func NewDiagnosticReporter(writer io.Writer, format string) *DiagnosticReporter {
+	return &DiagnosticReporter{
+		writer:  writer,
+		format:  format,
+		sources: make(map[string][]byte),
+	}
+}
+
+// AddSource registers the contents of file so text diagnostics can quote the offending line.
+func (r *DiagnosticReporter) AddSource(file string, data []byte) {
+	r.sources[file] = data
+}
+
+// Report writes diagnostics in the reporter's format and returns the exit code of the first one.
+func (r *DiagnosticReporter) Report(diagnostics ...*Diagnostic) int {
+	if len(diagnostics) == 0 {
+		return ExitOK
+	}
+
+	switch r.format {
+	case DiagnosticFormatJSON:
+		encoder := json.NewEncoder(r.writer)
+		encoder.SetIndent("", "  ")
+		encoder.Encode(diagnostics)
+	default:
+		for _, d := range diagnostics {
+			r.writeText(d)
+		}
+	}
+
+	return diagnostics[0].Kind.ExitCode()
+}
+
+func (r *DiagnosticReporter) writeText(d *Diagnostic) {
+	sb := strings.Builder{}
+	if d.File != "" {
+		sb.WriteString(d.File)
+		sb.WriteString(":")
+	}
+	if d.Line > 0 {
+		sb.WriteString(strconv.Itoa(d.Line))
+		sb.WriteString(":")
+		sb.WriteString(strconv.Itoa(d.Column))
+		sb.WriteString(":")
+	}
+	if sb.Len() > 0 {
+		sb.WriteString(" ")
+	}
+	sb.WriteString(string(d.Kind))
+	sb.WriteString(" error: ")
+	sb.WriteString(d.Message)
+	sb.WriteString("\n")
+
+	if line, ok := r.sourceLine(d.File, d.Line); ok {
+		gutter := strconv.Itoa(d.Line)
+		sb.WriteString(" ")
+		sb.WriteString(gutter)
+		sb.WriteString(" | ")
+		sb.WriteString(line)
+		sb.WriteString("\n")
+		sb.WriteString(strings.Repeat(" ", len(gutter)+1))
+		sb.WriteString(" | ")
+		for i := 0; i < d.Column-1 && i < len(line); i++ {
+			if line[i] == '\t' {
+				sb.WriteByte('\t')
+			} else {
+				sb.WriteByte(' ')
+			}
+		}
+		sb.WriteString("^\n")
+	}
+
+	fmt.Fprint(r.writer, sb.String())
+}
+
+func (r *DiagnosticReporter) sourceLine(file string, line int) (string, bool) {
+	data, ok := r.sources[file]
+	if !ok || line < 1 {
+		return "", false
+	}
+
+	lines := bytes.Split(data, []byte("\n"))
+	if line > len(lines) {
+		return "", false
+	}
+
+	return strings.TrimRight(string(lines[line-1]), "\r"), true
+}
@@ -0,0 +1,83 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/snowmerak/gidle/pkg/gidle"
+)
+
+// DiffChange is a change of `gidle diff -format json`.
+type DiffChange struct {
+	Breaking bool   `json:"breaking"`
+	File     string `json:"file,omitempty"`
+	Line     int    `json:"line,omitempty"`
+	Column   int    `json:"column,omitempty"`
+	Message  string `json:"message"`
+}
+
+// diffCommand prints the changes between two versions of a schema and exits with
+// ExitCheckFailed if any of them is breaking.
+func diffCommand(args []string) int {
+	flags := flag.NewFlagSet("gidle "+CommandDiff, flag.ContinueOnError)
+	flags.Usage = func() {
+		fmt.Fprintf(flags.Output(), "Usage: gidle %s [-format text|json] <old> <new>\n\n", CommandDiff)
+		flags.PrintDefaults()
+	}
+	format := flags.String("format", DiagnosticFormatText, "output format of changes and diagnostics (text or json)")
+	if err := flags.Parse(args); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return ExitOK
+		}
+		return ExitUsage
+	}
+
+	reporter, diagnostic := newReporter(*format)
+	if diagnostic != nil {
+		return reporter.Report(diagnostic)
+	}
+
+	if flags.NArg() != 2 {
+		flags.Usage()
+		return reporter.Report(&Diagnostic{Kind: DiagnosticUsage, Message: "diff needs the old and the new schema"})
+	}
+
+	old, diagnostics := compile(reporter, flags.Arg(0))
+	new, newDiagnostics := compile(reporter, flags.Arg(1))
+	if diagnostics = append(diagnostics, newDiagnostics...); len(diagnostics) > 0 {
+		return reporter.Report(diagnostics...)
+	}
+
+	changes := gidle.Diff(old, new)
+	breaking := false
+	result := make([]DiffChange, 0, len(changes))
+	for _, c := range changes {
+		breaking = breaking || c.Breaking
+		result = append(result, DiffChange{
+			Breaking: c.Breaking,
+			File:     c.Pos.Filename,
+			Line:     c.Pos.Line,
+			Column:   c.Pos.Column,
+			Message:  c.Message,
+		})
+	}
+
+	if *format == DiagnosticFormatJSON {
+		encoder := json.NewEncoder(os.Stdout)
+		encoder.SetIndent("", "  ")
+		encoder.Encode(result)
+	} else {
+		for _, c := range changes {
+			fmt.Println(c)
+		}
+	}
+
+	if breaking {
+		return ExitCheckFailed
+	}
+
+	return ExitOK
+}
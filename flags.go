@@ -0,0 +1,87 @@
+package main
+
+import (
+	"errors"
+	"path/filepath"
+	"strings"
+
+	"github.com/snowmerak/gidle/pkg/gidle"
+)
+
+// listFlag collects a flag that may be repeated or given as a comma separated list.
+type listFlag []string
+
+func (l *listFlag) String() string {
+	return strings.Join(*l, ",")
+}
+
+func (l *listFlag) Set(value string) error {
+	for _, v := range strings.Split(value, ",") {
+		v = strings.TrimSpace(v)
+		if v == "" {
+			continue
+		}
+		*l = append(*l, v)
+	}
+
+	return nil
+}
+
+// outputFlag collects -o values. A value is either `<lang>=<path>` for one language
+// or a bare path, which is the output file for a single language or the output
+// directory when several languages are generated.
+type outputFlag struct {
+	paths    map[string]string
+	fallback string
+}
+
+func (o *outputFlag) String() string {
+	return o.fallback
+}
+
+func (o *outputFlag) Set(value string) error {
+	lang, path, ok := strings.Cut(value, "=")
+	if !ok {
+		if o.fallback != "" {
+			return errors.New("output path is already set to " + o.fallback)
+		}
+		o.fallback = value
+		return nil
+	}
+
+	if o.paths == nil {
+		o.paths = make(map[string]string)
+	}
+	if _, ok := o.paths[lang]; ok {
+		return errors.New("output path for " + lang + " is already set")
+	}
+	o.paths[lang] = path
+
+	return nil
+}
+
+func (o *outputFlag) IsEmpty() bool {
+	return o.fallback == "" && len(o.paths) == 0
+}
+
+// Resolve returns the output path of lang for inputFile when languages are generated together.
+func (o *outputFlag) Resolve(lang string, languages []string, inputFile string) (string, error) {
+	if path, ok := o.paths[lang]; ok {
+		return path, nil
+	}
+
+	if o.fallback == "" {
+		return "", errors.New("no output path for " + lang)
+	}
+
+	if len(languages) == 1 {
+		return o.fallback, nil
+	}
+
+	if o.fallback == StdoutPath {
+		return "", errors.New("-o " + StdoutPath + " writes a single language, give " + lang + " its own output with -o " + lang + "=<path>")
+	}
+
+	name := strings.TrimSuffix(filepath.Base(inputFile), filepath.Ext(inputFile))
+	return filepath.Join(o.fallback, name+gidle.LanguageExtension(lang)), nil
+}
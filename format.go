@@ -0,0 +1,143 @@
+package main
+
+import (
+	"bytes"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+
+	"github.com/snowmerak/gidle/pkg/gidle"
+)
+
+// formatCommand prints the files, or stdin without files, in the canonical layout of gidle.Format.
+// Directories are searched for .gidle files.
+func formatCommand(args []string) int {
+	flags := flag.NewFlagSet("gidle "+CommandFormat, flag.ContinueOnError)
+	write := flags.Bool("w", false, "write the result to the files instead of stdout")
+	check := flags.Bool("check", false, "list the files that are not formatted and exit with 1 if there are any")
+	format := flags.String("format", DiagnosticFormatText, "diagnostic output format (text or json)")
+	if err := flags.Parse(args); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return ExitOK
+		}
+		return ExitUsage
+	}
+
+	reporter, diagnostic := newReporter(*format)
+	if diagnostic != nil {
+		return reporter.Report(diagnostic)
+	}
+
+	if *write && *check {
+		return reporter.Report(&Diagnostic{Kind: DiagnosticUsage, Message: "-w and -check cannot be used together"})
+	}
+
+	if flags.NArg() == 0 {
+		if *write {
+			return reporter.Report(&Diagnostic{Kind: DiagnosticUsage, Message: "-w needs files to write"})
+		}
+
+		source, err := io.ReadAll(os.Stdin)
+		if err != nil {
+			return reporter.Report(DiagnosticsFromError(DiagnosticIO, err)...)
+		}
+		reporter.AddSource("<stdin>", source)
+
+		formatted, err := gidle.Format("<stdin>", source)
+		if err != nil {
+			return reporter.Report(DiagnosticsFromError(DiagnosticParse, err)...)
+		}
+
+		if *check {
+			if !bytes.Equal(source, formatted) {
+				fmt.Println("<stdin>")
+				return ExitCheckFailed
+			}
+			return ExitOK
+		}
+
+		os.Stdout.Write(formatted)
+		return ExitOK
+	}
+
+	files, err := formatFiles(flags.Args())
+	if err != nil {
+		return reporter.Report(DiagnosticsFromError(DiagnosticIO, err)...)
+	}
+
+	var diagnostics []*Diagnostic
+	unformatted := false
+	for _, file := range files {
+		source, err := os.ReadFile(file)
+		if err != nil {
+			diagnostics = append(diagnostics, DiagnosticsFromError(DiagnosticIO, err)...)
+			continue
+		}
+		reporter.AddSource(file, source)
+
+		formatted, err := gidle.Format(file, source)
+		if err != nil {
+			diagnostics = append(diagnostics, DiagnosticsFromError(DiagnosticParse, err)...)
+			continue
+		}
+
+		switch {
+		case *check:
+			if !bytes.Equal(source, formatted) {
+				fmt.Println(file)
+				unformatted = true
+			}
+		case *write:
+			if !bytes.Equal(source, formatted) {
+				if err := writeFile(file, formatted); err != nil {
+					diagnostics = append(diagnostics, DiagnosticsFromError(DiagnosticIO, err)...)
+				}
+			}
+		default:
+			os.Stdout.Write(formatted)
+		}
+	}
+	if len(diagnostics) > 0 {
+		return reporter.Report(diagnostics...)
+	}
+
+	if unformatted {
+		return ExitCheckFailed
+	}
+
+	return ExitOK
+}
+
+// formatFiles returns the files of paths, replacing directories with the .gidle files below them.
+func formatFiles(paths []string) ([]string, error) {
+	var files []string
+	for _, path := range paths {
+		info, err := os.Stat(path)
+		if err != nil {
+			return nil, err
+		}
+		if !info.IsDir() {
+			files = append(files, path)
+			continue
+		}
+
+		err = filepath.WalkDir(path, func(p string, d fs.DirEntry, err error) error {
+			if err != nil {
+				return err
+			}
+			if !d.IsDir() && filepath.Ext(p) == ".gidle" {
+				files = append(files, p)
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return files, nil
+}
@@ -1,5 +0,0 @@
-package main
-
-type Generator interface {
-	Generate(outPath string, values *Grammar) error
-}
@@ -5,6 +5,7 @@ go 1.21.5
 require (
 	github.com/alecthomas/participle/v2 v2.1.1
 	golang.org/x/tools v0.16.1
+	gopkg.in/yaml.v3 v3.0.1
 )
 
 require golang.org/x/mod v0.14.0 // indirect
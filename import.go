@@ -0,0 +1,96 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/snowmerak/gidle/pkg/gidle"
+)
+
+// ImportFormats are the schema languages `gidle import` converts from.
+var ImportFormats = []string{ImportFormatJSONSchema}
+
+const ImportFormatJSONSchema = "jsonschema"
+
+// importCommand prints a schema converted from another schema language, read from a file or stdin.
+// Notes about constructs that cannot be converted are printed to stderr.
+func importCommand(args []string) int {
+	flags := flag.NewFlagSet("gidle "+CommandImport, flag.ContinueOnError)
+	flags.Usage = func() {
+		fmt.Fprintf(flags.Output(), "Usage: gidle %s <%s> [-n <object>] [-p <package>] [<file>]\n\n", CommandImport, strings.Join(ImportFormats, "|"))
+		flags.PrintDefaults()
+	}
+	name := flags.String("n", "", "name of the object of the document's own properties (default: the file name, or Root for stdin)")
+	pkg := flags.String("p", "", "package of the converted schema (default: the object name in lower case)")
+	format := flags.String("format", DiagnosticFormatText, "diagnostic output format (text or json)")
+
+	if len(args) == 0 || strings.HasPrefix(args[0], "-") {
+		if err := flags.Parse(args); errors.Is(err, flag.ErrHelp) {
+			return ExitOK
+		}
+		flags.Usage()
+		reporter, _ := newReporter(*format)
+		return reporter.Report(&Diagnostic{Kind: DiagnosticUsage, Message: "import needs a format: " + strings.Join(ImportFormats, ", ")})
+	}
+	from := args[0]
+	if err := flags.Parse(args[1:]); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return ExitOK
+		}
+		return ExitUsage
+	}
+
+	reporter, diagnostic := newReporter(*format)
+	if diagnostic != nil {
+		return reporter.Report(diagnostic)
+	}
+
+	if from != ImportFormatJSONSchema {
+		return reporter.Report(&Diagnostic{Kind: DiagnosticUsage, Message: "unknown import format " + from + ", expected one of " + strings.Join(ImportFormats, ", ")})
+	}
+	if flags.NArg() > 1 {
+		return reporter.Report(&Diagnostic{Kind: DiagnosticUsage, Message: "import converts one file at a time"})
+	}
+
+	file := "<stdin>"
+	var data []byte
+	var err error
+	if flags.NArg() == 0 {
+		data, err = io.ReadAll(os.Stdin)
+	} else {
+		file = flags.Arg(0)
+		data, err = os.ReadFile(file)
+	}
+	if err != nil {
+		return reporter.Report(DiagnosticsFromError(DiagnosticIO, err)...)
+	}
+
+	if *name == "" {
+		*name = "Root"
+		if flags.NArg() > 0 {
+			base := filepath.Base(file)
+			base = strings.TrimSuffix(base, ".json")
+			base = strings.TrimSuffix(base, ".schema")
+			*name = gidle.SnakeToPascal(strings.NewReplacer("-", "_", ".", "_", " ", "_").Replace(base))
+		}
+	}
+	if *pkg == "" {
+		*pkg = strings.ToLower(*name)
+	}
+
+	schema, notes, err := gidle.ImportJSONSchema(data, *pkg, *name)
+	for _, note := range notes {
+		fmt.Fprintln(os.Stderr, "note: "+note)
+	}
+	if err != nil {
+		return reporter.Report(&Diagnostic{Kind: DiagnosticParse, File: file, Message: err.Error()})
+	}
+	os.Stdout.Write(schema)
+
+	return ExitOK
+}
@@ -0,0 +1,77 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/snowmerak/gidle/pkg/gidle"
+)
+
+// inferCommand prints a schema inferred from JSON samples, or stdin without files.
+// Notes about the samples are printed to stderr.
+func inferCommand(args []string) int {
+	flags := flag.NewFlagSet("gidle "+CommandInfer, flag.ContinueOnError)
+	flags.Usage = func() {
+		fmt.Fprintf(flags.Output(), "Usage: gidle %s -n <object> [-p <package>] [<json file>...]\n\n", CommandInfer)
+		flags.PrintDefaults()
+	}
+	name := flags.String("n", "", "name of the inferred object")
+	pkg := flags.String("p", "", "package of the inferred schema (default: the object name in lower case)")
+	format := flags.String("format", DiagnosticFormatText, "diagnostic output format (text or json)")
+	if err := flags.Parse(args); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return ExitOK
+		}
+		return ExitUsage
+	}
+
+	reporter, diagnostic := newReporter(*format)
+	if diagnostic != nil {
+		return reporter.Report(diagnostic)
+	}
+
+	if *name == "" {
+		flags.Usage()
+		return reporter.Report(&Diagnostic{Kind: DiagnosticUsage, Message: "-n is required"})
+	}
+	if *pkg == "" {
+		*pkg = strings.ToLower(*name)
+	}
+
+	inferrer := gidle.NewInferrer(*name)
+	var diagnostics []*Diagnostic
+	if flags.NArg() == 0 {
+		data, err := io.ReadAll(os.Stdin)
+		if err != nil {
+			return reporter.Report(DiagnosticsFromError(DiagnosticIO, err)...)
+		}
+		if err := inferrer.Add(data); err != nil {
+			return reporter.Report(&Diagnostic{Kind: DiagnosticParse, File: "<stdin>", Message: err.Error()})
+		}
+	}
+	for _, file := range flags.Args() {
+		data, err := os.ReadFile(file)
+		if err != nil {
+			diagnostics = append(diagnostics, DiagnosticsFromError(DiagnosticIO, err)...)
+			continue
+		}
+		if err := inferrer.Add(data); err != nil {
+			diagnostics = append(diagnostics, &Diagnostic{Kind: DiagnosticParse, File: file, Message: err.Error()})
+		}
+	}
+	if len(diagnostics) > 0 {
+		return reporter.Report(diagnostics...)
+	}
+
+	schema, notes := inferrer.Schema(*pkg)
+	for _, note := range notes {
+		fmt.Fprintln(os.Stderr, "note: "+note)
+	}
+	os.Stdout.Write(schema)
+
+	return ExitOK
+}
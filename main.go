@@ -1,65 +1,269 @@
 package main
 
 import (
+	"errors"
 	"flag"
+	"fmt"
 	"os"
-	"path/filepath"
+	"sync"
 
-	"github.com/alecthomas/participle/v2"
+	"github.com/snowmerak/gidle/pkg/gidle"
 )
 
 const (
-	LanguageGo         = "go"
-	LanguageDart       = "dart"
-	LanguageTypeScript = "ts"
-	LanguageRust       = "rs"
-	LanguageCSharp     = "cs"
+	CommandGenerate = "generate"
+	CommandFormat   = "fmt"
+	CommandDiff     = "diff"
+	CommandValidate = "validate"
+	CommandInfer    = "infer"
+	CommandImport   = "import"
 )
 
+type generation struct {
+	lang      string
+	outPath   string
+	generator gidle.Generator
+	values    *gidle.Grammar
+	files     gidle.Files
+}
+
 func main() {
-	inputFile := flag.String("i", "", "input file")
-	outputFile := flag.String("o", "", "output file")
-	lang := flag.String("l", "", "output language")
-	flag.Parse()
+	if len(os.Args) > 1 {
+		switch os.Args[1] {
+		case CommandGenerate:
+			os.Exit(generateCommand(os.Args[2:]))
+		case CommandFormat:
+			os.Exit(formatCommand(os.Args[2:]))
+		case CommandDiff:
+			os.Exit(diffCommand(os.Args[2:]))
+		case CommandValidate:
+			os.Exit(validateCommand(os.Args[2:]))
+		case CommandInfer:
+			os.Exit(inferCommand(os.Args[2:]))
+		case CommandImport:
+			os.Exit(importCommand(os.Args[2:]))
+		}
+	}
+
+	os.Exit(rootCommand(os.Args[1:]))
+}
+
+func newReporter(format string) (*DiagnosticReporter, *Diagnostic) {
+	if format != DiagnosticFormatText && format != DiagnosticFormatJSON {
+		return NewDiagnosticReporter(os.Stderr, DiagnosticFormatText), &Diagnostic{Kind: DiagnosticUsage, Message: "unknown diagnostic format " + format}
+	}
+
+	return NewDiagnosticReporter(os.Stderr, format), nil
+}
+
+// rootCommand generates code for a single schema described by flags.
+func rootCommand(args []string) int {
+	var languages listFlag
+	var outputs outputFlag
+
+	flags := flag.NewFlagSet("gidle", flag.ContinueOnError)
+	flags.Usage = func() {
+		fmt.Fprintf(flags.Output(), "Usage: gidle -i <input> -o <output> -l <languages> [-dry-run]\n       gidle %s [-c <config>] [-dry-run]\n       gidle %s [-w | -check] [<file or directory>...]\n       gidle %s <old> <new>\n       gidle %s -i <input> -t <object> [<json file>...]\n       gidle %s -n <object> [-p <package>] [<json file>...]\n       gidle %s jsonschema [-n <object>] [-p <package>] [<file>]\n\n", CommandGenerate, CommandFormat, CommandDiff, CommandValidate, CommandInfer, CommandImport)
+		flags.PrintDefaults()
+	}
+	inputFile := flags.String("i", "", "input file")
+	flags.Var(&outputs, "o", "output file, output directory for several languages, or <lang>=<path> (repeatable); - writes to stdout")
+	flags.Var(&languages, "l", "output languages, comma separated or repeated")
+	format := flags.String("format", DiagnosticFormatText, "diagnostic output format (text or json)")
+	dryRun := flags.Bool("dry-run", false, "list the files that would be written without writing them")
+	if err := flags.Parse(args); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return ExitOK
+		}
+		return ExitUsage
+	}
+
+	reporter, diagnostic := newReporter(*format)
+	if diagnostic != nil {
+		return reporter.Report(diagnostic)
+	}
+
+	if *inputFile == "" || outputs.IsEmpty() || len(languages) == 0 {
+		flags.Usage()
+		return reporter.Report(&Diagnostic{Kind: DiagnosticUsage, Message: "-i, -o and -l are required"})
+	}
+
+	var usageErrors []*Diagnostic
+	generations := make([]generation, 0, len(languages))
+	for _, lang := range languages {
+		generator, err := gidle.NewGenerator(lang, nil)
+		if err != nil {
+			usageErrors = append(usageErrors, &Diagnostic{Kind: DiagnosticUsage, Message: err.Error()})
+			continue
+		}
+
+		outPath, err := outputs.Resolve(lang, languages, *inputFile)
+		if err != nil {
+			usageErrors = append(usageErrors, &Diagnostic{Kind: DiagnosticUsage, Message: err.Error()})
+			continue
+		}
+
+		generations = append(generations, generation{
+			lang:      lang,
+			outPath:   outPath,
+			generator: generator,
+		})
+	}
+	if len(usageErrors) > 0 {
+		return reporter.Report(usageErrors...)
+	}
+
+	values, diagnostics := compile(reporter, *inputFile)
+	if len(diagnostics) > 0 {
+		return reporter.Report(diagnostics...)
+	}
+
+	for i := range generations {
+		generations[i].values = values
+	}
+
+	if diagnostics := generate(generations); len(diagnostics) > 0 {
+		return reporter.Report(diagnostics...)
+	}
+
+	return reporter.Report(writeGenerations(os.Stdout, generations, *dryRun)...)
+}
+
+// generateCommand generates every input of the project configuration for every configured language.
+func generateCommand(args []string) int {
+	flags := flag.NewFlagSet("gidle "+CommandGenerate, flag.ContinueOnError)
+	configFile := flags.String("c", "", "configuration file (default: "+ConfigFileNames[0]+", "+ConfigFileNames[1]+" or "+ConfigFileNames[2]+" in the working directory)")
+	format := flags.String("format", DiagnosticFormatText, "diagnostic output format (text or json)")
+	dryRun := flags.Bool("dry-run", false, "list the files that would be written without writing them")
+	if err := flags.Parse(args); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return ExitOK
+		}
+		return ExitUsage
+	}
+
+	reporter, diagnostic := newReporter(*format)
+	if diagnostic != nil {
+		return reporter.Report(diagnostic)
+	}
 
-	if *inputFile == "" || *outputFile == "" || *lang == "" {
-		flag.Usage()
-		os.Exit(1)
+	if flags.NArg() > 0 {
+		return reporter.Report(&Diagnostic{Kind: DiagnosticUsage, Message: "unexpected arguments"})
 	}
 
-	data, err := os.ReadFile(*inputFile)
+	if *configFile == "" {
+		path, err := FindConfig(".")
+		if err != nil {
+			return reporter.Report(&Diagnostic{Kind: DiagnosticUsage, Message: err.Error()})
+		}
+		*configFile = path
+	}
+
+	config, err := LoadConfig(*configFile)
 	if err != nil {
-		panic(err)
+		var pathError *os.PathError
+		if errors.As(err, &pathError) {
+			return reporter.Report(DiagnosticsFromError(DiagnosticIO, err)...)
+		}
+		return reporter.Report(&Diagnostic{Kind: DiagnosticUsage, File: *configFile, Message: err.Error()})
 	}
 
-	parser := participle.MustBuild[Grammar]()
+	inputs, err := config.InputFiles()
+	if err != nil {
+		return reporter.Report(&Diagnostic{Kind: DiagnosticUsage, File: *configFile, Message: err.Error()})
+	}
 
-	values, err := parser.ParseBytes("", data)
+	var diagnostics []*Diagnostic
+	var generations []generation
+	for _, input := range inputs {
+		values, inputDiagnostics := compile(reporter, input.Path)
+		if len(inputDiagnostics) > 0 {
+			diagnostics = append(diagnostics, inputDiagnostics...)
+			continue
+		}
+
+		for _, lang := range config.LanguageNames() {
+			generator, err := gidle.NewGenerator(lang, config.Languages[lang].Options)
+			if err != nil {
+				return reporter.Report(&Diagnostic{Kind: DiagnosticUsage, File: *configFile, Message: err.Error()})
+			}
+
+			generations = append(generations, generation{
+				lang:      lang,
+				outPath:   config.OutputPath(lang, input, values),
+				generator: generator,
+				values:    values,
+			})
+		}
+	}
+	if len(diagnostics) > 0 {
+		return reporter.Report(diagnostics...)
+	}
+
+	if diagnostics := generate(generations); len(diagnostics) > 0 {
+		return reporter.Report(diagnostics...)
+	}
+
+	return reporter.Report(writeGenerations(os.Stdout, generations, *dryRun)...)
+}
+
+// compile loads and checks inputFile and every schema it imports, registering their sources with reporter.
+func compile(reporter *DiagnosticReporter, inputFile string) (*gidle.Grammar, []*Diagnostic) {
+	loader := gidle.NewLoader()
+	values, err := loader.Load(inputFile)
+	for path, data := range loader.Sources() {
+		reporter.AddSource(path, data)
+	}
 	if err != nil {
-		panic(err)
+		return nil, DiagnosticsFromError(DiagnosticIO, err)
 	}
 
-	if err := os.MkdirAll(filepath.Dir(*outputFile), 0755); err != nil {
-		panic(err)
+	var errs gidle.CheckErrors
+	for _, grammar := range loader.Grammars() {
+		if err := gidle.NewChecker().Check(grammar); err != nil {
+			errs = append(errs, err.(gidle.CheckErrors)...)
+		}
 	}
+	if len(errs) > 0 {
+		return nil, DiagnosticsFromError(DiagnosticSemantic, errs)
+	}
+
+	return values, nil
+}
 
-	var generator Generator
-	switch *lang {
-	case LanguageGo:
-		generator = NewGoGenerator()
-	case LanguageDart:
-		generator = NewDartGenerator()
-	case LanguageTypeScript:
-		generator = NewTypeScriptGenerator()
-	case LanguageRust:
-		generator = NewRustGenerator()
-	case LanguageCSharp:
-		generator = NewCSharpGenerator()
-	default:
-		panic("unknown language")
+// generate runs every generation concurrently, keeping the generated files in memory,
+// and returns the diagnostics of the ones that failed, in the order they were requested.
+func generate(generations []generation) []*Diagnostic {
+	results := make([][]*Diagnostic, len(generations))
+
+	wg := sync.WaitGroup{}
+	for i := range generations {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+
+			g := &generations[i]
+			files, err := g.generator.Generate(g.outPath, g.values)
+			if err != nil {
+				results[i] = DiagnosticsFromError(DiagnosticGenerate, err)
+				for _, d := range results[i] {
+					// Positioned errors point into the schema, the others are about the output.
+					if d.File == "" {
+						d.File = g.outPath
+					}
+					d.Message = g.lang + ": " + d.Message
+				}
+				return
+			}
+			g.files = files
+		}(i)
 	}
+	wg.Wait()
 
-	if err := generator.Generate(*outputFile, values); err != nil {
-		panic(err)
+	var diagnostics []*Diagnostic
+	for _, result := range results {
+		diagnostics = append(diagnostics, result...)
 	}
+
+	return diagnostics
 }
@@ -0,0 +1,149 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+)
+
+// StdoutPath is the output path that writes generated code to stdout.
+const StdoutPath = "-"
+
+// writeGenerations writes the files of every generation. Files are first written next
+// to their destination under temporary names and only renamed into place once all of
+// them were written, so a failed generation leaves the previous output untouched.
+// With dryRun the paths are listed on stdout instead. Nothing is written when two
+// generations write the same file, e.g. Go schemas of one package with the same name.
+func writeGenerations(stdout io.Writer, generations []generation, dryRun bool) []*Diagnostic {
+	var diagnostics []*Diagnostic
+	writers := make(map[string]*generation)
+	for i := range generations {
+		g := &generations[i]
+		if g.outPath == StdoutPath {
+			if len(g.files) > 1 {
+				diagnostics = append(diagnostics, &Diagnostic{
+					Kind:    DiagnosticUsage,
+					Message: g.lang + ": generates " + strconv.Itoa(len(g.files)) + " files, which cannot be written to stdout",
+				})
+			}
+			continue
+		}
+
+		for _, path := range g.files.Paths() {
+			key := filepath.Clean(path)
+			if prev, ok := writers[key]; ok {
+				diagnostics = append(diagnostics, &Diagnostic{
+					Kind:    DiagnosticUsage,
+					Message: g.lang + ": " + path + " is generated from both " + prev.values.Path + " and " + g.values.Path,
+				})
+				continue
+			}
+			writers[key] = g
+		}
+	}
+	if len(diagnostics) > 0 {
+		return diagnostics
+	}
+
+	if dryRun {
+		for _, g := range generations {
+			for _, path := range g.files.Paths() {
+				fmt.Fprintln(stdout, path)
+			}
+		}
+		return nil
+	}
+
+	var renames [][2]string
+	cleanup := func() {
+		for _, r := range renames {
+			os.Remove(r[0])
+		}
+	}
+	for _, g := range generations {
+		if g.outPath == StdoutPath {
+			continue
+		}
+
+		for _, path := range g.files.Paths() {
+			temp, err := writeTemp(path, g.files[path], 0644)
+			if err != nil {
+				cleanup()
+				return DiagnosticsFromError(DiagnosticIO, err)
+			}
+			renames = append(renames, [2]string{temp, path})
+		}
+	}
+
+	for i, r := range renames {
+		if err := os.Rename(r[0], r[1]); err != nil {
+			renames = renames[i:]
+			cleanup()
+			return DiagnosticsFromError(DiagnosticIO, err)
+		}
+	}
+
+	for _, g := range generations {
+		if g.outPath != StdoutPath {
+			continue
+		}
+
+		for _, path := range g.files.Paths() {
+			if _, err := stdout.Write(g.files[path]); err != nil {
+				return DiagnosticsFromError(DiagnosticIO, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// writeFile replaces the contents of path through a temporary file, keeping its permissions.
+func writeFile(path string, data []byte) error {
+	info, err := os.Stat(path)
+	if err != nil {
+		return err
+	}
+
+	temp, err := writeTemp(path, data, info.Mode().Perm())
+	if err != nil {
+		return err
+	}
+
+	if err := os.Rename(temp, path); err != nil {
+		os.Remove(temp)
+		return err
+	}
+
+	return nil
+}
+
+// writeTemp writes data to a new temporary file with permissions perm in the directory of path
+// and returns its name.
+func writeTemp(path string, data []byte, perm os.FileMode) (string, error) {
+	dir := filepath.Dir(path)
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return "", err
+	}
+
+	temp, err := os.CreateTemp(dir, "."+filepath.Base(path)+".*.tmp")
+	if err != nil {
+		return "", err
+	}
+
+	_, err = temp.Write(data)
+	if closeErr := temp.Close(); err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = os.Chmod(temp.Name(), perm)
+	}
+	if err != nil {
+		os.Remove(temp.Name())
+		return "", err
+	}
+
+	return temp.Name(), nil
+}
@@ -0,0 +1,351 @@
+package gidle
+
+import (
+	"fmt"
+	"math"
+	"strings"
+
+	"github.com/alecthomas/participle/v2/lexer"
+)
+
+type CheckError struct {
+	Pos     lexer.Position
+	Message string
+}
+
+func (e *CheckError) Error() string {
+	return e.Pos.String() + ": " + e.Message
+}
+
+type CheckErrors []*CheckError
+
+func (e CheckErrors) Error() string {
+	messages := make([]string, 0, len(e))
+	for _, err := range e {
+		messages = append(messages, err.Error())
+	}
+
+	return strings.Join(messages, "\n")
+}
+
+type entryKind int
+
+const (
+	entryKindConst entryKind = iota
+	entryKindEnum
+	entryKindObject
+)
+
+type Checker struct {
+	values  *Grammar
+	entries map[string]entryKind
+	errors  CheckErrors
+}
+
+func NewChecker() *Checker {
+	return &Checker{}
+}
+
+// Check validates a parsed grammar and returns every problem found as CheckErrors,
+// or nil when the grammar is safe to hand to a Generator.
+func (c *Checker) Check(values *Grammar) error {
+	c.values = values
+	c.entries = make(map[string]entryKind)
+	c.errors = nil
+
+	imported := make(map[string]lexer.Position)
+	for i := range values.Imports {
+		imp := &values.Imports[i]
+		qualifier := ImportQualifier(imp)
+		if prev, ok := imported[qualifier]; ok {
+			c.errorf(imp.Pos, "import name %q is already used at %s, add `as <name>` to rename it", qualifier, prev)
+			continue
+		}
+		imported[qualifier] = imp.Pos
+	}
+
+	declared := make(map[string]lexer.Position)
+	for _, entry := range values.Entries {
+		var (
+			name string
+			pos  lexer.Position
+			kind entryKind
+		)
+		if entry.Const != nil {
+			name, pos, kind = entry.Const.Name, entry.Const.Pos, entryKindConst
+		} else if entry.Enum != nil {
+			name, pos, kind = entry.Enum.Name, entry.Enum.Pos, entryKindEnum
+		} else if entry.Object != nil {
+			name, pos, kind = entry.Object.Name, entry.Object.Pos, entryKindObject
+		} else {
+			continue
+		}
+
+		if prev, ok := declared[name]; ok {
+			c.errorf(pos, "%q is already declared at %s", name, prev)
+			continue
+		}
+		declared[name] = pos
+		c.entries[name] = kind
+	}
+
+	for _, entry := range values.Entries {
+		if entry.Const != nil {
+			c.checkConst(entry.Const)
+		} else if entry.Enum != nil {
+			c.checkEnum(entry.Enum)
+		} else if entry.Object != nil {
+			c.checkObject(entry.Object)
+		}
+	}
+
+	if len(c.errors) > 0 {
+		return c.errors
+	}
+
+	return nil
+}
+
+func (c *Checker) errorf(pos lexer.Position, format string, args ...any) {
+	c.errors = append(c.errors, &CheckError{
+		Pos:     pos,
+		Message: fmt.Sprintf(format, args...),
+	})
+}
+
+func (c *Checker) checkConst(constant *Const) {
+	names := make(map[string]lexer.Position)
+	for _, f := range constant.Fields {
+		if prev, ok := names[f.Name]; ok {
+			c.errorf(f.Pos, "%s.%s is already declared at %s", constant.Name, f.Name, prev)
+			continue
+		}
+		names[f.Name] = f.Pos
+
+		c.checkPrimitiveValue(&f.Value, &constant.Type, constant.Name+"."+f.Name)
+	}
+}
+
+func (c *Checker) checkEnum(enum *Enum) {
+	names := make(map[string]lexer.Position)
+	values := make(map[string]string)
+	for _, v := range enum.Body {
+		if prev, ok := names[v.Name]; ok {
+			c.errorf(v.Pos, "%s.%s is already declared at %s", enum.Name, v.Name, prev)
+			continue
+		}
+		names[v.Name] = v.Pos
+
+		if !c.checkPrimitiveValue(&v.Value, &enum.Type, enum.Name+"."+v.Name) {
+			continue
+		}
+
+		key := primitiveValueString(&v.Value)
+		if prev, ok := values[key]; ok {
+			c.errorf(v.Value.Pos, "%s.%s has the same value as %s.%s", enum.Name, v.Name, enum.Name, prev)
+			continue
+		}
+		values[key] = v.Name
+	}
+}
+
+func (c *Checker) checkObject(object *Object) {
+	names := make(map[string]lexer.Position)
+	numbers := make(map[int64]string)
+	jsonNames := make(map[string]string)
+	for _, f := range object.Fields {
+		if prev, ok := names[f.Name]; ok {
+			c.errorf(f.Pos, "field %s.%s is already declared at %s", object.Name, f.Name, prev)
+			continue
+		}
+		names[f.Name] = f.Pos
+
+		c.checkType(&f.Type)
+		c.checkAttributes(object, &f, numbers)
+
+		jsonName := JSONName(&f)
+		if prev, ok := jsonNames[jsonName]; ok {
+			c.errorf(f.Pos, "JSON name %q of %s.%s is already used by %s.%s", jsonName, object.Name, f.Name, object.Name, prev)
+			continue
+		}
+		jsonNames[jsonName] = f.Name
+	}
+
+	// Implicit numbers follow declaration order, so mixing them with `@proto` numbers lets a new
+	// field silently renumber its neighbours.
+	numbered := 0
+	for i := range object.Fields {
+		if FieldAttribute(&object.Fields[i], AttributeProto) != nil {
+			numbered++
+		}
+	}
+	if numbered == 0 || numbered == len(object.Fields) {
+		return
+	}
+	for _, f := range object.Fields {
+		if FieldAttribute(&f, AttributeProto) == nil {
+			c.errorf(f.Pos, "field %s.%s needs @proto, as other fields of %s set their protobuf field number", object.Name, f.Name, object.Name)
+		}
+	}
+}
+
+func (c *Checker) checkAttributes(object *Object, f *ObjectField, numbers map[int64]string) {
+	name := object.Name + "." + f.Name
+	seen := make(map[string]lexer.Position)
+	for _, attr := range f.Attributes {
+		if prev, ok := seen[attr.Name]; ok {
+			c.errorf(attr.Pos, "@%s is already set on %s at %s", attr.Name, name, prev)
+			continue
+		}
+		seen[attr.Name] = attr.Pos
+
+		switch attr.Name {
+		case AttributeProto:
+			if attr.Value == nil || attr.Value.IntValue == nil {
+				c.errorf(attr.Pos, "@%s on %s needs a field number, e.g. @%s(1)", attr.Name, name, attr.Name)
+				continue
+			}
+			number := *attr.Value.IntValue
+			if number < 1 || number > ProtoMaxFieldNumber || number >= ProtoReservedFieldNumberMin && number <= ProtoReservedFieldNumberMax {
+				c.errorf(attr.Value.Pos, "field number %d of %s is outside 1 to %d or inside the reserved range %d to %d",
+					number, name, ProtoMaxFieldNumber, ProtoReservedFieldNumberMin, ProtoReservedFieldNumberMax)
+				continue
+			}
+			if prev, ok := numbers[number]; ok {
+				c.errorf(attr.Value.Pos, "field number %d of %s is already used by %s.%s", number, name, object.Name, prev)
+				continue
+			}
+			numbers[number] = f.Name
+		case AttributeJSON:
+			if attr.Value == nil || attr.Value.StringValue == nil || *attr.Value.StringValue == "" {
+				c.errorf(attr.Pos, "@%s on %s needs a non-empty name, e.g. @%s(\"user-id\")", attr.Name, name, attr.Name)
+				continue
+			}
+			// Go struct tags cannot quote these, and a comma would add tag options like omitempty.
+			if i := strings.IndexAny(*attr.Value.StringValue, ",\"`\\"); i >= 0 {
+				c.errorf(attr.Value.Pos, "@%s name %q of %s must not contain %q", attr.Name, *attr.Value.StringValue, name, (*attr.Value.StringValue)[i])
+			}
+		default:
+			c.errorf(attr.Pos, "unknown attribute @%s on %s", attr.Name, name)
+		}
+	}
+}
+
+func (c *Checker) checkType(t *Type) {
+	if t.ListType != nil {
+		c.checkType(&t.ListType.ElementType)
+	} else if t.MapType != nil {
+		c.checkType(&t.MapType.ValueType)
+	} else if t.Identity != nil {
+		qualifier, _ := SplitIdentity(*t.Identity)
+		if qualifier != "" {
+			c.checkQualifiedType(t, qualifier)
+			return
+		}
+
+		kind, ok := c.entries[*t.Identity]
+		switch {
+		case !ok:
+			c.errorf(t.Pos, "undefined type %q", *t.Identity)
+		case kind == entryKindConst:
+			c.errorf(t.Pos, "%q is a const and cannot be used as a type", *t.Identity)
+		}
+	}
+}
+
+func (c *Checker) checkQualifiedType(t *Type, qualifier string) {
+	imp := LookupImport(c.values, qualifier)
+	if imp == nil {
+		c.errorf(t.Pos, "undefined import %q in %q", qualifier, *t.Identity)
+		return
+	}
+	if imp.Grammar == nil {
+		c.errorf(t.Pos, "import %q is not loaded", imp.Path)
+		return
+	}
+
+	_, entry := LookupEntry(c.values, *t.Identity)
+	switch {
+	case entry == nil:
+		c.errorf(t.Pos, "undefined type %q in %s", *t.Identity, imp.Path)
+	case entry.Const != nil:
+		c.errorf(t.Pos, "%q is a const and cannot be used as a type", *t.Identity)
+	}
+}
+
+// checkPrimitiveValue reports whether value is assignable to t and fits in its range.
+func (c *Checker) checkPrimitiveValue(value *PrimitiveValue, t *PrimitiveType, name string) bool {
+	switch t.Type {
+	case "int8", "int16", "int32", "int64", "uint8", "uint16", "uint32", "uint64":
+		if value.IntValue == nil {
+			c.errorf(value.Pos, "%s must be an integer for %s", name, t.Type)
+			return false
+		}
+		min, max := integerRange(t.Type)
+		if *value.IntValue < min || *value.IntValue > 0 && uint64(*value.IntValue) > max {
+			c.errorf(value.Pos, "%s value %d overflows %s", name, *value.IntValue, t.Type)
+			return false
+		}
+	case "float32", "float64":
+		var f float64
+		if value.FloatValue != nil {
+			f = *value.FloatValue
+		} else if value.IntValue != nil {
+			f = float64(*value.IntValue)
+		} else {
+			c.errorf(value.Pos, "%s must be a number for %s", name, t.Type)
+			return false
+		}
+		if t.Type == "float32" && math.Abs(f) > math.MaxFloat32 {
+			c.errorf(value.Pos, "%s value %v overflows %s", name, f, t.Type)
+			return false
+		}
+	case "string":
+		if value.StringValue == nil {
+			c.errorf(value.Pos, "%s must be a string", name)
+			return false
+		}
+	case "bool":
+		if value.BoolValue == nil {
+			c.errorf(value.Pos, "%s must be true or false", name)
+			return false
+		}
+	}
+
+	return true
+}
+
+func integerRange(t string) (min int64, max uint64) {
+	switch t {
+	case "int8":
+		return math.MinInt8, math.MaxInt8
+	case "int16":
+		return math.MinInt16, math.MaxInt16
+	case "int32":
+		return math.MinInt32, math.MaxInt32
+	case "int64":
+		return math.MinInt64, math.MaxInt64
+	case "uint8":
+		return 0, math.MaxUint8
+	case "uint16":
+		return 0, math.MaxUint16
+	case "uint32":
+		return 0, math.MaxUint32
+	default:
+		return 0, math.MaxUint64
+	}
+}
+
+func primitiveValueString(value *PrimitiveValue) string {
+	if value.StringValue != nil {
+		return *value.StringValue
+	} else if value.IntValue != nil {
+		return fmt.Sprint(*value.IntValue)
+	} else if value.FloatValue != nil {
+		return fmt.Sprint(*value.FloatValue)
+	} else if value.BoolValue != nil {
+		return fmt.Sprint(bool(*value.BoolValue))
+	}
+
+	return ""
+}
@@ -0,0 +1,171 @@
+package gidle
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestChecker(t *testing.T) {
+	tests := []struct {
+		name   string
+		schema string
+		want   []string
+	}{
+		{
+			name: "valid",
+			schema: `package acme
+enum Kind for uint8 {
+    A = 0
+    B = 255
+}
+object Thing {
+    @proto(2) Kind kind
+    @proto(1) string name
+}`,
+		},
+		{
+			name: "duplicate declaration",
+			schema: `package acme
+object Thing {
+    string name
+}
+enum Thing for int32 {
+    A = 1
+}`,
+			want: []string{`5:1: "Thing" is already declared at test.gidle:2:1`},
+		},
+		{
+			name: "duplicate names and values",
+			schema: `package acme
+const C for string {
+    X = "x"
+    X = "y"
+}
+enum E for int32 {
+    A = 1
+    A = 2
+    B = 1
+}
+object O {
+    string name
+    int32 name
+    @json("name") string other
+}`,
+			want: []string{
+				`4:5: C.X is already declared at test.gidle:3:5`,
+				`8:5: E.A is already declared at test.gidle:7:5`,
+				`9:9: E.B has the same value as E.A`,
+				`13:5: field O.name is already declared at test.gidle:12:5`,
+				`14:5: JSON name "name" of O.other is already used by O.name`,
+			},
+		},
+		{
+			name: "undefined types",
+			schema: `package acme
+const C for int32 {
+    X = 1
+}
+object O {
+    Missing a
+    list of C b
+    other.Thing c
+}`,
+			want: []string{
+				`6:5: undefined type "Missing"`,
+				`7:13: "C" is a const and cannot be used as a type`,
+				`8:5: undefined import "other" in "other.Thing"`,
+			},
+		},
+		{
+			name: "range overflow",
+			schema: `package acme
+const C for int8 {
+    BIG = 128
+    FRACTION = 1.5
+}
+enum E for uint8 {
+    LARGE = 256
+    NAME = "x"
+}
+const F for float32 {
+    HUGE = 1e39
+}
+const S for string {
+    N = 1
+}`,
+			want: []string{
+				`3:11: C.BIG value 128 overflows int8`,
+				`4:16: C.FRACTION must be an integer for int8`,
+				`7:13: E.LARGE value 256 overflows uint8`,
+				`8:12: E.NAME must be an integer for uint8`,
+				`11:12: F.HUGE value 1e+39 overflows float32`,
+				`14:9: S.N must be a string`,
+			},
+		},
+		{
+			name: "proto numbers",
+			schema: `package acme
+object O {
+    @proto(1) string a
+    @proto(1) string b
+    @proto(0) string c
+    @proto(19000) string d
+    @proto(536870912) string e
+    @proto string f
+    @proto(2) @proto(3) string g
+}
+object P {
+    @proto(1) string a
+    string b
+    optional int32 c
+}`,
+			want: []string{
+				`4:12: field number 1 of O.b is already used by O.a`,
+				`5:12: field number 0 of O.c is outside 1 to 536870911 or inside the reserved range 19000 to 19999`,
+				`6:12: field number 19000 of O.d is outside 1 to 536870911 or inside the reserved range 19000 to 19999`,
+				`7:12: field number 536870912 of O.e is outside 1 to 536870911 or inside the reserved range 19000 to 19999`,
+				`8:5: @proto on O.f needs a field number, e.g. @proto(1)`,
+				`9:15: @proto is already set on O.g at test.gidle:9:5`,
+				`13:5: field P.b needs @proto, as other fields of P set their protobuf field number`,
+				`14:5: field P.c needs @proto, as other fields of P set their protobuf field number`,
+			},
+		},
+		{
+			name: "attributes",
+			schema: `package acme
+object O {
+    @json("h,omitempty") string h
+    @deprecated string i
+}`,
+			want: []string{
+				`3:11: @json name "h,omitempty" of O.h must not contain ','`,
+				`4:5: unknown attribute @deprecated on O.i`,
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			values, err := NewParser().ParseString("test.gidle", test.schema)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var got []string
+			if err := NewChecker().Check(values); err != nil {
+				var errs CheckErrors
+				if !errors.As(err, &errs) {
+					t.Fatalf("Check returned %T, want CheckErrors", err)
+				}
+				for _, e := range errs {
+					got = append(got, strings.TrimPrefix(e.Error(), "test.gidle:"))
+				}
+			}
+
+			if strings.Join(got, "\n") != strings.Join(test.want, "\n") {
+				t.Errorf("errors differ:\n%s", diffLines(strings.Join(test.want, "\n"), strings.Join(got, "\n")))
+			}
+		})
+	}
+}
@@ -0,0 +1,339 @@
+package gidle
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/alecthomas/participle/v2/lexer"
+)
+
+// Change is a difference between two versions of a schema.
+type Change struct {
+	// Breaking is set when code generated from one version cannot read
+	// data written by code generated from the other.
+	Breaking bool
+	Pos      lexer.Position
+	Message  string
+}
+
+func (c *Change) String() string {
+	kind := "compatible"
+	if c.Breaking {
+		kind = "breaking"
+	}
+
+	return c.Pos.String() + ": " + kind + ": " + c.Message
+}
+
+type differ struct {
+	old     *Grammar
+	new     *Grammar
+	changes []*Change
+}
+
+// Diff compares two checked versions of a schema. Fields are matched by their JSON name,
+// so a field renamed with `@json` keeping the old name is compatible. Changes in the new
+// schema are reported at their position in new, removals at their position in old.
+func Diff(old, new *Grammar) []*Change {
+	d := &differ{old: old, new: new}
+
+	if oldPackage, newPackage := strings.Join(old.Package.Names, "."), strings.Join(new.Package.Names, "."); oldPackage != newPackage {
+		d.breaking(new.Package.Pos, "package %s renamed to %s", oldPackage, newPackage)
+	}
+
+	var removed, added []*Object
+	for _, entry := range old.Entries {
+		_, next := LookupEntry(new, entryName(&entry))
+		switch {
+		case entry.Const != nil:
+			if next == nil || next.Const == nil {
+				d.breaking(entry.Const.Pos, "const %s removed", entry.Const.Name)
+				continue
+			}
+			d.diffConst(entry.Const, next.Const)
+		case entry.Enum != nil:
+			if next == nil || next.Enum == nil {
+				d.breaking(entry.Enum.Pos, "enum %s removed", entry.Enum.Name)
+				continue
+			}
+			d.diffEnum(entry.Enum, next.Enum)
+		case entry.Object != nil:
+			if next == nil || next.Object == nil {
+				removed = append(removed, entry.Object)
+				continue
+			}
+			d.diffObject(entry.Object, next.Object)
+		}
+	}
+
+	for _, entry := range new.Entries {
+		if _, prev := LookupEntry(old, entryName(&entry)); prev != nil {
+			continue
+		}
+		switch {
+		case entry.Const != nil:
+			d.compatible(entry.Const.Pos, "const %s added", entry.Const.Name)
+		case entry.Enum != nil:
+			d.compatible(entry.Enum.Pos, "enum %s added", entry.Enum.Name)
+		case entry.Object != nil:
+			added = append(added, entry.Object)
+		}
+	}
+
+	// An object removed while another one with the same fields is added was renamed.
+	for _, object := range removed {
+		renamed := false
+		for i, next := range added {
+			if next != nil && d.sameFields(object, next) {
+				d.breaking(next.Pos, "object %s renamed to %s", object.Name, next.Name)
+				added[i] = nil
+				renamed = true
+				break
+			}
+		}
+		if !renamed {
+			d.breaking(object.Pos, "object %s removed", object.Name)
+		}
+	}
+	for _, object := range added {
+		if object != nil {
+			d.compatible(object.Pos, "object %s added", object.Name)
+		}
+	}
+
+	return d.changes
+}
+
+func (d *differ) breaking(pos lexer.Position, format string, args ...any) {
+	d.add(true, pos, format, args...)
+}
+
+func (d *differ) compatible(pos lexer.Position, format string, args ...any) {
+	d.add(false, pos, format, args...)
+}
+
+func (d *differ) add(breaking bool, pos lexer.Position, format string, args ...any) {
+	d.changes = append(d.changes, &Change{
+		Breaking: breaking,
+		Pos:      pos,
+		Message:  fmt.Sprintf(format, args...),
+	})
+}
+
+func (d *differ) diffConst(old, new *Const) {
+	if old.Type.Type != new.Type.Type {
+		d.breaking(new.Pos, "const %s changed type from %s to %s", old.Name, old.Type.Type, new.Type.Type)
+		return
+	}
+
+	for _, f := range old.Fields {
+		next := findConstField(new, f.Name)
+		if next == nil {
+			d.breaking(f.Pos, "const %s.%s removed", old.Name, f.Name)
+			continue
+		}
+		if primitiveValueString(&f.Value) != primitiveValueString(&next.Value) {
+			d.breaking(next.Pos, "const %s.%s changed from %s to %s", old.Name, f.Name, diffValue(&f.Value), diffValue(&next.Value))
+		}
+	}
+
+	for _, f := range new.Fields {
+		if findConstField(old, f.Name) == nil {
+			d.compatible(f.Pos, "const %s.%s added", new.Name, f.Name)
+		}
+	}
+}
+
+func (d *differ) diffEnum(old, new *Enum) {
+	if old.Type.Type != new.Type.Type {
+		d.breaking(new.Pos, "enum %s changed type from %s to %s", old.Name, old.Type.Type, new.Type.Type)
+		return
+	}
+
+	for _, v := range old.Body {
+		value := primitiveValueString(&v.Value)
+		if next := findEnumValue(new, v.Name); next != nil {
+			if nextValue := primitiveValueString(&next.Value); nextValue != value {
+				d.breaking(next.Pos, "enum value %s.%s renumbered from %s to %s", old.Name, v.Name, diffValue(&v.Value), diffValue(&next.Value))
+			}
+			continue
+		}
+
+		// The value is what is written, so a value keeping its number under a new name is compatible.
+		if next := findEnumValueOf(new, value); next != nil && findEnumValue(old, next.Name) == nil {
+			d.compatible(next.Pos, "enum value %s.%s renamed to %s", old.Name, v.Name, next.Name)
+			continue
+		}
+		d.breaking(v.Pos, "enum value %s.%s removed", old.Name, v.Name)
+	}
+
+	for _, v := range new.Body {
+		if findEnumValue(old, v.Name) != nil {
+			continue
+		}
+		if prev := findEnumValueOf(old, primitiveValueString(&v.Value)); prev != nil && findEnumValue(new, prev.Name) == nil {
+			continue
+		}
+		// Decoders generated from the old schema reject values they do not know.
+		d.breaking(v.Pos, "enum value %s.%s added", new.Name, v.Name)
+	}
+}
+
+func (d *differ) diffObject(old, new *Object) {
+	oldNumbers := ProtoFieldNumbers(old)
+	newNumbers := ProtoFieldNumbers(new)
+
+	for i := range old.Fields {
+		f := &old.Fields[i]
+		j := findJSONField(new, JSONName(f))
+		if j < 0 {
+			if IsOptionalField(f) {
+				d.compatible(f.Pos, "optional field %s.%s removed", old.Name, f.Name)
+			} else {
+				d.breaking(f.Pos, "field %s.%s removed", old.Name, f.Name)
+			}
+			continue
+		}
+		next := &new.Fields[j]
+
+		if f.Name != next.Name {
+			d.compatible(next.Pos, "field %s.%s renamed to %s, its JSON name is unchanged", old.Name, f.Name, next.Name)
+		}
+		if oldType, newType := d.typeName(d.old, &f.Type), d.typeName(d.new, &next.Type); oldType != newType {
+			d.breaking(next.Type.Pos, "field %s.%s changed type from %s to %s", old.Name, f.Name, FormatType(&f.Type), FormatType(&next.Type))
+		}
+		if IsOptionalField(f) && !IsOptionalField(next) {
+			d.breaking(next.Pos, "field %s.%s is no longer optional", old.Name, f.Name)
+		} else if !IsOptionalField(f) && IsOptionalField(next) {
+			d.breaking(next.Pos, "field %s.%s became optional", old.Name, f.Name)
+		}
+		// Implicit numbers follow declaration order, so only fields pinned with `@proto` promise one.
+		if (hasProtoNumber(f) || hasProtoNumber(next)) && oldNumbers[i] != newNumbers[j] {
+			d.breaking(next.Pos, "field %s.%s changed protobuf field number from %d to %d", old.Name, f.Name, oldNumbers[i], newNumbers[j])
+		}
+	}
+
+	for i := range new.Fields {
+		f := &new.Fields[i]
+		if findJSONField(old, JSONName(f)) >= 0 {
+			continue
+		}
+		if IsOptionalField(f) {
+			d.compatible(f.Pos, "optional field %s.%s added", new.Name, f.Name)
+		} else {
+			d.breaking(f.Pos, "required field %s.%s added", new.Name, f.Name)
+		}
+	}
+}
+
+// hasProtoNumber reports whether f sets its protobuf field number with `@proto(n)`.
+func hasProtoNumber(f *ObjectField) bool {
+	attr := FieldAttribute(f, AttributeProto)
+	return attr != nil && attr.Value != nil && attr.Value.IntValue != nil
+}
+
+// sameFields reports whether old and new have fields of the same JSON names, types and optionality.
+func (d *differ) sameFields(old, new *Object) bool {
+	if len(old.Fields) != len(new.Fields) {
+		return false
+	}
+
+	for i := range old.Fields {
+		j := findJSONField(new, JSONName(&old.Fields[i]))
+		if j < 0 ||
+			d.typeName(d.old, &old.Fields[i].Type) != d.typeName(d.new, &new.Fields[j].Type) ||
+			IsOptionalField(&old.Fields[i]) != IsOptionalField(&new.Fields[j]) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// typeName returns t with references resolved to the package declaring them,
+// so that renaming an import does not change the type.
+func (d *differ) typeName(values *Grammar, t *Type) string {
+	switch {
+	case t.ListType != nil:
+		return "list of " + d.typeName(values, &t.ListType.ElementType)
+	case t.MapType != nil:
+		return "map " + t.MapType.KeyType.Type + " for " + d.typeName(values, &t.MapType.ValueType)
+	case t.Identity != nil:
+		declaring, _ := LookupEntry(values, *t.Identity)
+		if declaring == nil {
+			return *t.Identity
+		}
+		_, name := SplitIdentity(*t.Identity)
+		// Types of the schema itself are compared by name, since both versions declare them.
+		if declaring == d.old || declaring == d.new {
+			return name
+		}
+		return strings.Join(declaring.Package.Names, ".") + "." + name
+	default:
+		return FormatType(t)
+	}
+}
+
+// diffValue returns value as written in a schema.
+func diffValue(value *PrimitiveValue) string {
+	if value.StringValue != nil {
+		return strconv.Quote(*value.StringValue)
+	}
+
+	return primitiveValueString(value)
+}
+
+func entryName(entry *Entry) string {
+	switch {
+	case entry.Const != nil:
+		return entry.Const.Name
+	case entry.Enum != nil:
+		return entry.Enum.Name
+	case entry.Object != nil:
+		return entry.Object.Name
+	default:
+		return ""
+	}
+}
+
+func findConstField(constant *Const, name string) *ConstField {
+	for i := range constant.Fields {
+		if constant.Fields[i].Name == name {
+			return &constant.Fields[i]
+		}
+	}
+
+	return nil
+}
+
+func findEnumValue(enum *Enum, name string) *EnumValue {
+	for i := range enum.Body {
+		if enum.Body[i].Name == name {
+			return &enum.Body[i]
+		}
+	}
+
+	return nil
+}
+
+func findEnumValueOf(enum *Enum, value string) *EnumValue {
+	for i := range enum.Body {
+		if primitiveValueString(&enum.Body[i].Value) == value {
+			return &enum.Body[i]
+		}
+	}
+
+	return nil
+}
+
+// findJSONField returns the index of the field of object written as name in JSON, or -1.
+func findJSONField(object *Object, name string) int {
+	for i := range object.Fields {
+		if JSONName(&object.Fields[i]) == name {
+			return i
+		}
+	}
+
+	return -1
+}
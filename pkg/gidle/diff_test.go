@@ -0,0 +1,49 @@
+package gidle
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// TestDiff compares testdata/diff/old.gidle with testdata/diff/new.gidle and
+// the changes found with testdata/diff/changes.golden.
+func TestDiff(t *testing.T) {
+	root := filepath.Join("testdata", "diff")
+	old, err := ParseFile(filepath.Join(root, "old.gidle"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	new, err := ParseFile(filepath.Join(root, "new.gidle"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var sb strings.Builder
+	for _, c := range Diff(old, new) {
+		sb.WriteString(filepath.ToSlash(c.String()))
+		sb.WriteString("\n")
+	}
+	got := sb.String()
+
+	golden := filepath.Join(root, "changes.golden")
+	if *update {
+		if err := os.WriteFile(golden, []byte(got), 0644); err != nil {
+			t.Fatal(err)
+		}
+		return
+	}
+
+	want, err := os.ReadFile(golden)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got != string(want) {
+		t.Errorf("changes differ from %s:\n%s", golden, diffLines(string(want), got))
+	}
+
+	if changes := Diff(old, old); len(changes) > 0 {
+		t.Errorf("a schema compared with itself has changes: %v", changes)
+	}
+}
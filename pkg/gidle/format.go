@@ -0,0 +1,301 @@
+package gidle
+
+import (
+	"bytes"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/alecthomas/participle/v2/lexer"
+)
+
+// FormatIndent is the indentation of fields, enum values and const fields in formatted schemas.
+const FormatIndent = "    "
+
+// formatLine is a line of a formatted block. Lines with cells are aligned
+// with the neighbouring lines that have cells, up to the next blank line.
+type formatLine struct {
+	text    string
+	cells   []string
+	comment string
+	blank   bool
+}
+
+type formatter struct {
+	buffer   *bytes.Buffer
+	source   []byte
+	comments []lexer.Token
+	// line is the source line the last formatted node or comment ended on.
+	line int
+}
+
+// Format parses source and prints it in the canonical layout: declarations separated by one
+// blank line, fields indented by FormatIndent with their names aligned, and at most one blank
+// line kept between fields. Comments are kept before the declaration or at the end of the line
+// they were written at. Values are printed as written, e.g. hexadecimal integers stay hexadecimal.
+func Format(filename string, source []byte) ([]byte, error) {
+	values, err := NewParser().ParseBytes(filename, source)
+	if err != nil {
+		return nil, err
+	}
+
+	lex, err := gidleLexer.LexString(filename, string(source))
+	if err != nil {
+		return nil, err
+	}
+	tokens, err := lexer.ConsumeAll(lex)
+	if err != nil {
+		return nil, err
+	}
+
+	f := &formatter{
+		buffer: bytes.NewBuffer(nil),
+		source: source,
+	}
+	comment := gidleLexer.Symbols()["Comment"]
+	for _, token := range tokens {
+		if token.Type == comment {
+			f.comments = append(f.comments, token)
+		}
+	}
+
+	f.formatGrammar(values)
+
+	return f.buffer.Bytes(), nil
+}
+
+func (f *formatter) formatGrammar(values *Grammar) {
+	var lines []formatLine
+	f.leading(&lines, values.Package.Pos.Offset)
+	f.blankBefore(&lines, values.Package.Pos.Line)
+	lines = append(lines, formatLine{
+		text:    "package " + strings.Join(values.Package.Names, "."),
+		comment: f.trailing(values.Package.EndPos),
+	})
+	f.line = values.Package.EndPos.Line
+
+	for i, imp := range values.Imports {
+		if i == 0 {
+			lines = append(lines, formatLine{blank: true})
+		}
+		f.leading(&lines, imp.Pos.Offset)
+		f.blankBefore(&lines, imp.Pos.Line)
+		text := "import " + strconv.Quote(imp.Path)
+		if imp.Alias != nil {
+			text += " as " + *imp.Alias
+		}
+		lines = append(lines, formatLine{text: text, comment: f.trailing(imp.EndPos)})
+		f.line = imp.EndPos.Line
+	}
+	f.writeLines("", lines)
+
+	for _, entry := range values.Entries {
+		f.buffer.WriteString("\n")
+		if entry.Const != nil {
+			f.formatConst(entry.Const)
+		} else if entry.Enum != nil {
+			f.formatEnum(entry.Enum)
+		} else if entry.Object != nil {
+			f.formatObject(entry.Object)
+		}
+	}
+
+	// Comments after the last declaration.
+	if len(f.comments) > 0 {
+		lines = nil
+		f.buffer.WriteString("\n")
+		f.line = 0
+		f.leading(&lines, len(f.source)+1)
+		f.writeLines("", lines)
+	}
+}
+
+func (f *formatter) formatConst(constant *Const) {
+	f.formatBlock(constant.Pos, constant.EndPos, constant.Doc, "const "+constant.Name+" for "+constant.Type.Type, func(lines *[]formatLine) {
+		for _, field := range constant.Fields {
+			f.formatValueLine(lines, field.Pos, field.EndPos, field.Doc, field.Name, &field.Value)
+		}
+	})
+}
+
+func (f *formatter) formatEnum(enum *Enum) {
+	f.formatBlock(enum.Pos, enum.EndPos, enum.Doc, "enum "+enum.Name+" for "+enum.Type.Type, func(lines *[]formatLine) {
+		for _, v := range enum.Body {
+			f.formatValueLine(lines, v.Pos, v.EndPos, v.Doc, v.Name, &v.Value)
+		}
+	})
+}
+
+func (f *formatter) formatObject(object *Object) {
+	f.formatBlock(object.Pos, object.EndPos, object.Doc, "object "+object.Name, func(lines *[]formatLine) {
+		for _, field := range object.Fields {
+			var sb strings.Builder
+			for _, attr := range field.Attributes {
+				sb.WriteString("@")
+				sb.WriteString(attr.Name)
+				if attr.Value != nil {
+					sb.WriteString("(")
+					sb.WriteString(f.text(attr.Value))
+					sb.WriteString(")")
+				}
+				sb.WriteString(" ")
+			}
+			if field.Optional {
+				sb.WriteString("optional ")
+			}
+			sb.WriteString(FormatType(&field.Type))
+			if field.Nullable {
+				sb.WriteString("?")
+			}
+
+			f.leading(lines, field.Pos.Offset)
+			f.blankBefore(lines, field.Pos.Line)
+			*lines = append(*lines, docLines(field.Doc)...)
+			*lines = append(*lines, formatLine{cells: []string{sb.String(), field.Name}, comment: f.trailing(field.EndPos)})
+			f.line = field.EndPos.Line
+		}
+	})
+}
+
+// formatBlock writes a declaration whose body, added to lines by body, is enclosed in braces.
+func (f *formatter) formatBlock(pos lexer.Position, end lexer.Position, doc Doc, header string, body func(lines *[]formatLine)) {
+	var lines []formatLine
+	f.leading(&lines, pos.Offset)
+	f.blankBefore(&lines, pos.Line)
+	lines = append(lines, docLines(doc)...)
+	f.writeLines("", lines)
+
+	f.buffer.WriteString(header)
+	f.buffer.WriteString(" {\n")
+
+	lines = nil
+	f.line = 0
+	body(&lines)
+	f.leading(&lines, end.Offset)
+	f.writeLines(FormatIndent, lines)
+
+	f.buffer.WriteString("}")
+	if comment := f.trailing(end); comment != "" {
+		f.buffer.WriteString(" ")
+		f.buffer.WriteString(comment)
+	}
+	f.buffer.WriteString("\n")
+	f.line = end.Line
+}
+
+func (f *formatter) formatValueLine(lines *[]formatLine, pos lexer.Position, end lexer.Position, doc Doc, name string, value *PrimitiveValue) {
+	f.leading(lines, pos.Offset)
+	f.blankBefore(lines, pos.Line)
+	*lines = append(*lines, docLines(doc)...)
+	*lines = append(*lines, formatLine{cells: []string{name, "= " + f.text(value)}, comment: f.trailing(end)})
+	f.line = end.Line
+}
+
+// text returns value as written in the source.
+func (f *formatter) text(value *PrimitiveValue) string {
+	return string(f.source[value.Pos.Offset:value.EndPos.Offset])
+}
+
+// leading adds the comments before offset to lines.
+func (f *formatter) leading(lines *[]formatLine, offset int) {
+	for len(f.comments) > 0 && f.comments[0].Pos.Offset < offset {
+		c := f.comments[0]
+		f.comments = f.comments[1:]
+
+		f.blankBefore(lines, c.Pos.Line)
+		*lines = append(*lines, formatLine{text: c.Value})
+		f.line = c.Pos.Line + strings.Count(c.Value, "\n")
+	}
+}
+
+// trailing returns the comments written before end, inside the element ending there, and the
+// ones after end on the same line, e.g. both comments of `int32 /* inline */ count // trailing`.
+func (f *formatter) trailing(end lexer.Position) string {
+	var comments []string
+	for len(f.comments) > 0 && (f.comments[0].Pos.Offset < end.Offset || f.comments[0].Pos.Line == end.Line) {
+		comments = append(comments, f.comments[0].Value)
+		f.comments = f.comments[1:]
+	}
+
+	return strings.Join(comments, " ")
+}
+
+// blankBefore keeps one blank line before something starting at line if the source had any.
+func (f *formatter) blankBefore(lines *[]formatLine, line int) {
+	if len(*lines) > 0 && !(*lines)[len(*lines)-1].blank && f.line > 0 && line > f.line+1 {
+		*lines = append(*lines, formatLine{blank: true})
+	}
+}
+
+func (f *formatter) writeLines(indent string, lines []formatLine) {
+	for start := 0; start < len(lines); {
+		end := start
+		for end < len(lines) && !lines[end].blank {
+			end++
+		}
+
+		// Align the cells of every line up to the next blank line.
+		var widths []int
+		for _, line := range lines[start:end] {
+			for i, cell := range line.cells {
+				if i >= len(widths) {
+					widths = append(widths, 0)
+				}
+				if n := utf8.RuneCountInString(cell); n > widths[i] {
+					widths[i] = n
+				}
+			}
+		}
+
+		for _, line := range lines[start:end] {
+			text := line.text
+			if line.cells != nil {
+				var sb strings.Builder
+				for i, cell := range line.cells {
+					sb.WriteString(cell)
+					if i < len(line.cells)-1 {
+						sb.WriteString(strings.Repeat(" ", widths[i]-utf8.RuneCountInString(cell)+1))
+					}
+				}
+				text = sb.String()
+			}
+			if line.comment != "" {
+				text += " " + line.comment
+			}
+			f.buffer.WriteString(indent)
+			f.buffer.WriteString(text)
+			f.buffer.WriteString("\n")
+		}
+
+		if end < len(lines) {
+			f.buffer.WriteString("\n")
+			end++
+		}
+		start = end
+	}
+}
+
+func docLines(doc Doc) []formatLine {
+	lines := make([]formatLine, 0, len(doc))
+	for _, line := range doc {
+		lines = append(lines, formatLine{text: strings.TrimRight("/// "+line, " ")})
+	}
+
+	return lines
+}
+
+// FormatType returns t as written in a schema, e.g. `map string for list of int32`.
+func FormatType(t *Type) string {
+	switch {
+	case t.PrimitiveType != nil:
+		return t.PrimitiveType.Type
+	case t.ListType != nil:
+		return "list of " + FormatType(&t.ListType.ElementType)
+	case t.MapType != nil:
+		return "map " + t.MapType.KeyType.Type + " for " + FormatType(&t.MapType.ValueType)
+	case t.Identity != nil:
+		return *t.Identity
+	default:
+		return ""
+	}
+}
@@ -0,0 +1,56 @@
+package gidle
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// TestFormat formats every testdata/format/*.gidle file and compares it with the .golden
+// file next to it. Formatting the result again must not change it.
+func TestFormat(t *testing.T) {
+	inputs, err := filepath.Glob(filepath.Join("testdata", "format", "*.gidle"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, input := range inputs {
+		t.Run(filepath.Base(input), func(t *testing.T) {
+			source, err := os.ReadFile(input)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			got, err := Format(input, source)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			golden := strings.TrimSuffix(input, filepath.Ext(input)) + ".golden"
+			if *update {
+				if err := os.WriteFile(golden, got, 0644); err != nil {
+					t.Fatal(err)
+				}
+				return
+			}
+
+			want, err := os.ReadFile(golden)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !bytes.Equal(got, want) {
+				t.Errorf("%s differs from %s:\n%s", input, golden, diffLines(string(want), string(got)))
+			}
+
+			again, err := Format(golden, got)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !bytes.Equal(again, got) {
+				t.Errorf("formatting %s again changes it:\n%s", golden, diffLines(string(got), string(again)))
+			}
+		})
+	}
+}
@@ -0,0 +1,279 @@
+package gidle
+
+import (
+	"bytes"
+	"errors"
+	"path"
+	"sort"
+	"strings"
+	"sync"
+)
+
+const (
+	LanguageGo         = "go"
+	LanguageDart       = "dart"
+	LanguageTypeScript = "ts"
+	LanguageRust       = "rs"
+	LanguageCSharp     = "cs"
+	LanguageJSONSchema = "jsonschema"
+	LanguagePython     = "py"
+	LanguageKotlin     = "kt"
+	LanguageSwift      = "swift"
+	LanguageJava       = "java"
+	LanguageProto      = "proto"
+)
+
+type Generator interface {
+	// Generate returns the files generated from values. outPath is the output file, or the
+	// output directory of generators writing a file per type, and prefixes every returned path.
+	Generate(outPath string, values *Grammar) (Files, error)
+}
+
+// Files maps the paths of generated files to their contents.
+type Files map[string][]byte
+
+// Paths returns the paths of f in a stable order.
+func (f Files) Paths() []string {
+	paths := make([]string, 0, len(f))
+	for p := range f {
+		paths = append(paths, p)
+	}
+	sort.Strings(paths)
+
+	return paths
+}
+
+// GeneratorOptions are language specific settings, e.g. the Go package name.
+type GeneratorOptions map[string]string
+
+// Only returns an error naming every option that is not in known.
+func (o GeneratorOptions) Only(lang string, known ...string) error {
+	var unknown []string
+	for key := range o {
+		found := false
+		for _, k := range known {
+			if key == k {
+				found = true
+				break
+			}
+		}
+		if !found {
+			unknown = append(unknown, key)
+		}
+	}
+
+	if len(unknown) > 0 {
+		sort.Strings(unknown)
+		return errors.New("unknown " + lang + " options: " + strings.Join(unknown, ", "))
+	}
+
+	return nil
+}
+
+// GeneratorFactory creates a generator configured by options.
+type GeneratorFactory func(options GeneratorOptions) (Generator, error)
+
+var (
+	generatorsMu sync.RWMutex
+	generators   = make(map[string]GeneratorFactory)
+)
+
+func init() {
+	RegisterGenerator(LanguageGo, func(options GeneratorOptions) (Generator, error) {
+		if err := options.Only(LanguageGo, "package", "module"); err != nil {
+			return nil, err
+		}
+		g := NewGoGenerator()
+		g.PackageName = options["package"]
+		g.ModulePath = options["module"]
+		return g, nil
+	})
+	RegisterGenerator(LanguageDart, func(options GeneratorOptions) (Generator, error) {
+		if err := options.Only(LanguageDart); err != nil {
+			return nil, err
+		}
+		return NewDartGenerator(), nil
+	})
+	RegisterGenerator(LanguageTypeScript, func(options GeneratorOptions) (Generator, error) {
+		if err := options.Only(LanguageTypeScript, "module", "map"); err != nil {
+			return nil, err
+		}
+		t := NewTypeScriptGenerator()
+		switch options["module"] {
+		case "", TypeScriptModuleNamespace, TypeScriptModuleESM:
+			t.ModuleStyle = options["module"]
+		default:
+			return nil, errors.New("unknown ts module style " + options["module"])
+		}
+		switch options["map"] {
+		case "", TypeScriptMapMap, TypeScriptMapRecord:
+			t.MapStyle = options["map"]
+		default:
+			return nil, errors.New("unknown ts map style " + options["map"])
+		}
+		return t, nil
+	})
+	RegisterGenerator(LanguageRust, func(options GeneratorOptions) (Generator, error) {
+		if err := options.Only(LanguageRust); err != nil {
+			return nil, err
+		}
+		return NewRustGenerator(), nil
+	})
+	RegisterGenerator(LanguageCSharp, func(options GeneratorOptions) (Generator, error) {
+		if err := options.Only(LanguageCSharp, "namespace"); err != nil {
+			return nil, err
+		}
+		cs := NewCSharpGenerator()
+		cs.Namespace = options["namespace"]
+		return cs, nil
+	})
+	RegisterGenerator(LanguageJSONSchema, func(options GeneratorOptions) (Generator, error) {
+		if err := options.Only(LanguageJSONSchema); err != nil {
+			return nil, err
+		}
+		return NewJSONSchemaGenerator(), nil
+	})
+	RegisterGenerator(LanguagePython, func(options GeneratorOptions) (Generator, error) {
+		if err := options.Only(LanguagePython); err != nil {
+			return nil, err
+		}
+		return NewPythonGenerator(), nil
+	})
+	RegisterGenerator(LanguageKotlin, func(options GeneratorOptions) (Generator, error) {
+		if err := options.Only(LanguageKotlin); err != nil {
+			return nil, err
+		}
+		return NewKotlinGenerator(), nil
+	})
+	RegisterGenerator(LanguageSwift, func(options GeneratorOptions) (Generator, error) {
+		if err := options.Only(LanguageSwift); err != nil {
+			return nil, err
+		}
+		return NewSwiftGenerator(), nil
+	})
+	RegisterGenerator(LanguageJava, func(options GeneratorOptions) (Generator, error) {
+		if err := options.Only(LanguageJava, "class"); err != nil {
+			return nil, err
+		}
+		j := NewJavaGenerator()
+		switch options["class"] {
+		case "", JavaClassRecord, JavaClassPOJO:
+			j.ClassStyle = options["class"]
+		default:
+			return nil, errors.New("unknown java class style " + options["class"])
+		}
+		return j, nil
+	})
+	RegisterGenerator(LanguageProto, func(options GeneratorOptions) (Generator, error) {
+		if err := options.Only(LanguageProto); err != nil {
+			return nil, err
+		}
+		return NewProtoGenerator(), nil
+	})
+}
+
+// RegisterGenerator makes a generator available to NewGenerator as lang.
+// It panics if lang is already registered.
+func RegisterGenerator(lang string, factory GeneratorFactory) {
+	generatorsMu.Lock()
+	defer generatorsMu.Unlock()
+
+	if _, ok := generators[lang]; ok {
+		panic("gidle: generator " + lang + " is already registered")
+	}
+	generators[lang] = factory
+}
+
+// Languages returns the registered languages in a stable order.
+func Languages() []string {
+	generatorsMu.RLock()
+	defer generatorsMu.RUnlock()
+
+	languages := make([]string, 0, len(generators))
+	for lang := range generators {
+		languages = append(languages, lang)
+	}
+	sort.Strings(languages)
+
+	return languages
+}
+
+// NewGenerator creates the generator registered as lang.
+func NewGenerator(lang string, options GeneratorOptions) (Generator, error) {
+	generatorsMu.RLock()
+	factory, ok := generators[lang]
+	generatorsMu.RUnlock()
+	if !ok {
+		return nil, errors.New("unknown language " + lang)
+	}
+
+	return factory(options)
+}
+
+// LanguageExtension returns the file extension used for files generated in lang.
+func LanguageExtension(lang string) string {
+	switch lang {
+	case LanguageGo:
+		return ".go"
+	case LanguageDart:
+		return ".dart"
+	case LanguageTypeScript:
+		return ".ts"
+	case LanguageRust:
+		return ".rs"
+	case LanguageCSharp:
+		return ".cs"
+	case LanguageJSONSchema:
+		return ".schema.json"
+	case LanguagePython:
+		return ".py"
+	case LanguageKotlin:
+		return ".kt"
+	case LanguageSwift:
+		return ".swift"
+	case LanguageJava:
+		// Java output is a directory with one file per type.
+		return ""
+	case LanguageProto:
+		return ".proto"
+	default:
+		return "." + lang
+	}
+}
+
+// ImportOutputPath returns the slash separated path of the generated file for imp
+// relative to the file importing it, assuming both are generated side by side.
+func ImportOutputPath(imp *Import, ext string) string {
+	return strings.TrimSuffix(imp.Path, path.Ext(imp.Path)) + ext
+}
+
+// joinDoc returns a followed by b as one doc, separated by an empty line when both have text.
+func joinDoc(a, b Doc) Doc {
+	if len(a) == 0 || len(b) == 0 {
+		return append(append(Doc{}, a...), b...)
+	}
+
+	return append(append(append(Doc{}, a...), ""), b...)
+}
+
+// writeLineDoc writes doc as line comments starting with prefix, e.g. "/// " for rustdoc.
+func writeLineDoc(buffer *bytes.Buffer, indent string, prefix string, doc Doc) {
+	for _, line := range doc {
+		buffer.WriteString(indent)
+		buffer.WriteString(strings.TrimRight(prefix+line, " "))
+		buffer.WriteString("\n")
+	}
+}
+
+// writeBlockDoc writes doc as a `/** */` comment, the form of JSDoc, KDoc and Javadoc.
+func writeBlockDoc(buffer *bytes.Buffer, indent string, doc Doc) {
+	if len(doc) == 0 {
+		return
+	}
+
+	buffer.WriteString(indent)
+	buffer.WriteString("/**\n")
+	writeLineDoc(buffer, indent, " * ", Doc(strings.Split(strings.ReplaceAll(strings.Join(doc, "\n"), "*/", "*\\/"), "\n")))
+	buffer.WriteString(indent)
+	buffer.WriteString(" */\n")
+}
@@ -1,27 +1,47 @@
-package main
+package gidle
 
 import (
 	"bytes"
-	"os"
 	"strconv"
+	"strings"
 )
 
 type CSharpGenerator struct {
 	buffer *bytes.Buffer
+
+	// Namespace overrides the schema package as the dot separated C# namespace.
+	Namespace string
 }
 
 func NewCSharpGenerator() *CSharpGenerator {
 	return &CSharpGenerator{buffer: bytes.NewBuffer(nil)}
 }
 
-func (cs *CSharpGenerator) Generate(outPath string, values *Grammar) error {
+func (cs *CSharpGenerator) Generate(outPath string, values *Grammar) (Files, error) {
 	cs.buffer.Reset()
 
 	cs.buffer.WriteString("using System.Text.Json;\n")
 	cs.buffer.WriteString("using System.Text.Json.Serialization;\n")
+	for i := range values.Imports {
+		imp := &values.Imports[i]
+		if imp.Grammar == nil {
+			continue
+		}
+
+		cs.buffer.WriteString("using ")
+		cs.buffer.WriteString(ImportQualifier(imp))
+		cs.buffer.WriteString(" = ")
+		cs.buffer.WriteString(strings.Join(imp.Grammar.Package.Names, "."))
+		cs.buffer.WriteString(";\n")
+	}
 	cs.buffer.WriteString("\n")
 
-	for _, name := range values.Package.Names {
+	names := values.Package.Names
+	if cs.Namespace != "" {
+		names = strings.Split(cs.Namespace, ".")
+	}
+
+	for _, name := range names {
 		cs.buffer.WriteString("namespace ")
 		cs.buffer.WriteString(name)
 		cs.buffer.WriteString(" {\n")
@@ -37,15 +57,11 @@ func (cs *CSharpGenerator) Generate(outPath string, values *Grammar) error {
 		}
 	}
 
-	for range values.Package.Names {
+	for range names {
 		cs.buffer.WriteString("}\n")
 	}
 
-	if err := os.WriteFile(outPath, cs.buffer.Bytes(), 0644); err != nil {
-		return err
-	}
-
-	return nil
+	return Files{outPath: bytes.Clone(cs.buffer.Bytes())}, nil
 }
 
 func (cs *CSharpGenerator) generatePrimitiveValue(value *PrimitiveValue) {
@@ -58,18 +74,40 @@ func (cs *CSharpGenerator) generatePrimitiveValue(value *PrimitiveValue) {
 	} else if value.FloatValue != nil {
 		cs.buffer.WriteString(strconv.FormatFloat(*value.FloatValue, 'f', -1, 64))
 	} else if value.BoolValue != nil {
-		cs.buffer.WriteString(strconv.FormatBool(*value.BoolValue))
+		cs.buffer.WriteString(strconv.FormatBool(bool(*value.BoolValue)))
 	} else {
 		cs.buffer.WriteString("null")
 	}
 }
 
+var csharpDocEscaper = strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;")
+
+// generateDoc writes doc as an XML documentation summary.
+func (cs *CSharpGenerator) generateDoc(indent string, doc Doc) {
+	if len(doc) == 0 {
+		return
+	}
+
+	escaped := make(Doc, 0, len(doc))
+	for _, line := range doc {
+		escaped = append(escaped, csharpDocEscaper.Replace(line))
+	}
+
+	cs.buffer.WriteString(indent)
+	cs.buffer.WriteString("/// <summary>\n")
+	writeLineDoc(cs.buffer, indent, "/// ", escaped)
+	cs.buffer.WriteString(indent)
+	cs.buffer.WriteString("/// </summary>\n")
+}
+
 func (cs *CSharpGenerator) generateConst(constant *Const) {
+	cs.generateDoc("", constant.Doc)
 	cs.buffer.WriteString("public static class ")
 	cs.buffer.WriteString(constant.Name)
 	cs.buffer.WriteString(" {\n")
 
 	for _, f := range constant.Fields {
+		cs.generateDoc("", f.Doc)
 		cs.buffer.WriteString("public const ")
 		cs.generatePrimitiveType(&constant.Type)
 		cs.buffer.WriteString(" ")
@@ -83,11 +121,13 @@ func (cs *CSharpGenerator) generateConst(constant *Const) {
 }
 
 func (cs *CSharpGenerator) generateEnum(enum *Enum) {
+	cs.generateDoc("", enum.Doc)
 	cs.buffer.WriteString("public class ")
 	cs.buffer.WriteString(enum.Name)
 	cs.buffer.WriteString(" {\n")
 
 	for _, v := range enum.Body {
+		cs.generateDoc("\t", v.Doc)
 		cs.buffer.WriteString("\tpublic const ")
 		cs.generatePrimitiveType(&enum.Type)
 		cs.buffer.WriteString(" ")
@@ -143,6 +183,13 @@ func (cs *CSharpGenerator) generateType(t *Type) {
 	}
 }
 
+func (cs *CSharpGenerator) generateFieldType(f *ObjectField) {
+	cs.generateType(&f.Type)
+	if IsOptionalField(f) {
+		cs.buffer.WriteString("?")
+	}
+}
+
 func (cs *CSharpGenerator) generatePrimitiveType(t *PrimitiveType) {
 	switch t.Type {
 	case "uint8":
@@ -184,22 +231,26 @@ func (cs *CSharpGenerator) generateMapType(t *MapType) {
 	cs.buffer.WriteString("Dictionary<")
 	cs.generatePrimitiveType(&t.KeyType)
 	cs.buffer.WriteString(", ")
-	cs.generatePrimitiveType(&t.ValueType)
+	cs.generateType(&t.ValueType)
 	cs.buffer.WriteString(">")
 }
 
 func (cs *CSharpGenerator) generateObject(object *Object) {
+	cs.generateDoc("", object.Doc)
 	cs.buffer.WriteString("public class ")
 	cs.buffer.WriteString(object.Name)
 	cs.buffer.WriteString(" {\n")
 
 	for _, f := range object.Fields {
+		cs.generateDoc("", f.Doc)
+		if IsOptionalField(&f) {
+			cs.buffer.WriteString("[JsonIgnore(Condition = JsonIgnoreCondition.WhenWritingNull)]\n")
+		}
 		cs.buffer.WriteString("[JsonPropertyName(")
-		cs.buffer.WriteString("\"")
-		cs.buffer.WriteString(f.Name)
-		cs.buffer.WriteString("\")]\n")
+		cs.buffer.WriteString(strconv.Quote(JSONName(&f)))
+		cs.buffer.WriteString(")]\n")
 		cs.buffer.WriteString("public ")
-		cs.generateType(&f.Type)
+		cs.generateFieldType(&f)
 		cs.buffer.WriteString(" ")
 		cs.buffer.WriteString(SnakeToPascal(f.Name))
 		cs.buffer.WriteString(" { get; set; }\n")
@@ -213,7 +264,7 @@ func (cs *CSharpGenerator) generateObject(object *Object) {
 		if i > 0 {
 			cs.buffer.WriteString(", ")
 		}
-		cs.generateType(&f.Type)
+		cs.generateFieldType(&f)
 		cs.buffer.WriteString(" ")
 		cs.buffer.WriteString(f.Name)
 	}
@@ -0,0 +1,388 @@
+package gidle
+
+import (
+	"bytes"
+	"strconv"
+	"strings"
+)
+
+type DartGenerator struct {
+	buffer *bytes.Buffer
+	values *Grammar
+}
+
+func NewDartGenerator() *DartGenerator {
+	return &DartGenerator{
+		buffer: bytes.NewBuffer(nil),
+	}
+}
+
+func (d *DartGenerator) Generate(outPath string, values *Grammar) (Files, error) {
+	d.buffer.Reset()
+	d.values = values
+
+	d.buffer.WriteString("import 'dart:convert';\n")
+	for i := range values.Imports {
+		imp := &values.Imports[i]
+		d.buffer.WriteString("import '")
+		d.buffer.WriteString(ImportOutputPath(imp, ".dart"))
+		d.buffer.WriteString("' as ")
+		d.buffer.WriteString(ImportQualifier(imp))
+		d.buffer.WriteString(";\n")
+	}
+	d.buffer.WriteString("\n")
+
+	for _, entry := range values.Entries {
+		if entry.Const != nil {
+			d.generateConst(entry.Const)
+		} else if entry.Enum != nil {
+			d.generateEnum(entry.Enum)
+		} else if entry.Object != nil {
+			d.generateObject(entry.Object)
+		}
+	}
+
+	return Files{outPath: bytes.Clone(d.buffer.Bytes())}, nil
+}
+
+func (d *DartGenerator) generatePrimitiveValue(value *PrimitiveValue) {
+	if value.StringValue != nil {
+		d.buffer.WriteString("\"")
+		d.buffer.WriteString(*value.StringValue)
+		d.buffer.WriteString("\"")
+	} else if value.IntValue != nil {
+		d.buffer.WriteString(strconv.FormatInt(*value.IntValue, 10))
+	} else if value.FloatValue != nil {
+		d.buffer.WriteString(strconv.FormatFloat(*value.FloatValue, 'f', -1, 64))
+	} else if value.BoolValue != nil {
+		d.buffer.WriteString(strconv.FormatBool(bool(*value.BoolValue)))
+	} else {
+		d.buffer.WriteString("null")
+	}
+}
+
+func (d *DartGenerator) generateConst(constant *Const) {
+	for _, f := range constant.Fields {
+		writeLineDoc(d.buffer, "", "/// ", joinDoc(constant.Doc, f.Doc))
+		d.buffer.WriteString("const ")
+		d.buffer.WriteString(constant.Name)
+		d.buffer.WriteString("_")
+		d.buffer.WriteString(f.Name)
+		d.buffer.WriteString(" = ")
+		d.generatePrimitiveValue(&f.Value)
+		d.buffer.WriteString(";\n")
+	}
+	d.buffer.WriteString("\n")
+}
+
+func (d *DartGenerator) generateEnum(enum *Enum) {
+	writeLineDoc(d.buffer, "", "/// ", enum.Doc)
+	d.buffer.WriteString("enum ")
+	d.buffer.WriteString(enum.Name)
+	d.buffer.WriteString(" {\n")
+	for i, v := range enum.Body {
+		writeLineDoc(d.buffer, "\t", "/// ", v.Doc)
+		d.buffer.WriteString("\t")
+		d.buffer.WriteString(v.Name)
+		d.buffer.WriteString("(")
+		d.generatePrimitiveValue(&v.Value)
+		if i == len(enum.Body)-1 {
+			d.buffer.WriteString(");\n")
+		} else {
+			d.buffer.WriteString("),\n")
+		}
+	}
+	d.buffer.WriteString("\n")
+
+	d.buffer.WriteString("\tfinal ")
+	d.generatePrimitiveType(&enum.Type)
+	d.buffer.WriteString(" value;\n\n")
+
+	d.buffer.WriteString("\tconst ")
+	d.buffer.WriteString(enum.Name)
+	d.buffer.WriteString("(this.value);\n\n")
+
+	d.buffer.WriteString("\tstatic ")
+	d.buffer.WriteString(enum.Name)
+	d.buffer.WriteString(" fromValue(")
+	d.generatePrimitiveType(&enum.Type)
+	d.buffer.WriteString(" value) {\n")
+	d.buffer.WriteString("\t\treturn ")
+	d.buffer.WriteString(enum.Name)
+	d.buffer.WriteString(".values.firstWhere((e) => e.value == value, orElse: () => throw ArgumentError.value(value, \"value\", \"unknown ")
+	d.buffer.WriteString(enum.Name)
+	d.buffer.WriteString(" value\"));\n")
+	d.buffer.WriteString("\t}\n")
+	d.buffer.WriteString("}\n\n")
+}
+
+func (d *DartGenerator) generateType(t *Type) {
+	if t.PrimitiveType != nil {
+		d.generatePrimitiveType(t.PrimitiveType)
+	} else if t.ListType != nil {
+		d.generateListType(t.ListType)
+	} else if t.MapType != nil {
+		d.generateMapType(t.MapType)
+	} else if t.Identity != nil {
+		d.buffer.WriteString(*t.Identity)
+	} else {
+		d.buffer.WriteString("unknown type")
+	}
+}
+
+func (d *DartGenerator) generatePrimitiveType(t *PrimitiveType) {
+	switch t.Type {
+	case "string":
+		d.buffer.WriteString("String")
+	case "int8", "int16", "int32", "int64", "uint8", "uint16", "uint32", "uint64":
+		d.buffer.WriteString("int")
+	case "float32", "float64":
+		d.buffer.WriteString("double")
+	case "bool":
+		d.buffer.WriteString("bool")
+	default:
+		d.buffer.WriteString("unknown type")
+	}
+}
+
+func (d *DartGenerator) generateListType(t *ListType) {
+	d.buffer.WriteString("List<")
+	d.generateType(&t.ElementType)
+	d.buffer.WriteString(">")
+}
+
+func (d *DartGenerator) generateMapType(t *MapType) {
+	d.buffer.WriteString("Map<")
+	d.generatePrimitiveType(&t.KeyType)
+	d.buffer.WriteString(", ")
+	d.generateType(&t.ValueType)
+	d.buffer.WriteString(">")
+}
+
+func (d *DartGenerator) generateObject(object *Object) {
+	writeLineDoc(d.buffer, "", "/// ", object.Doc)
+	d.buffer.WriteString("class ")
+	d.buffer.WriteString(object.Name)
+	d.buffer.WriteString(" {\n")
+
+	for _, f := range object.Fields {
+		writeLineDoc(d.buffer, "\t", "/// ", f.Doc)
+		d.buffer.WriteString("\t")
+		d.generateType(&f.Type)
+		if IsOptionalField(&f) {
+			d.buffer.WriteString("?")
+		}
+		d.buffer.WriteString(" ")
+		d.buffer.WriteString(SnakeToCamel(f.Name))
+		d.buffer.WriteString(";\n")
+	}
+	d.buffer.WriteString("\n")
+
+	d.buffer.WriteString("\t")
+	d.buffer.WriteString(object.Name)
+	d.buffer.WriteString("({\n")
+	for _, f := range object.Fields {
+		d.buffer.WriteString("\t\t")
+		if !IsOptionalField(&f) {
+			d.buffer.WriteString("required ")
+		}
+		d.buffer.WriteString("this.")
+		d.buffer.WriteString(SnakeToCamel(f.Name))
+		d.buffer.WriteString(",\n")
+	}
+	d.buffer.WriteString("\t});\n\n")
+
+	d.buffer.WriteString("\tMap<String, dynamic> toMap() {\n")
+	d.buffer.WriteString("\t\treturn {\n")
+	for _, f := range object.Fields {
+		d.buffer.WriteString("\t\t\t")
+		d.buffer.WriteString(dartQuote(JSONName(&f)))
+		d.buffer.WriteString(": ")
+		d.generateToMapExpression(&f.Type, SnakeToCamel(f.Name), IsOptionalField(&f))
+		d.buffer.WriteString(",\n")
+	}
+	d.buffer.WriteString("\t\t};\n")
+	d.buffer.WriteString("\t}\n\n")
+
+	d.buffer.WriteString("\tString toJson() {\n")
+	d.buffer.WriteString("\t\treturn jsonEncode(toMap());\n")
+	d.buffer.WriteString("\t}\n\n")
+
+	d.buffer.WriteString("\t")
+	d.buffer.WriteString(object.Name)
+	d.buffer.WriteString(".fromMap(Map<String, dynamic> map)")
+	for i, f := range object.Fields {
+		if i == 0 {
+			d.buffer.WriteString("\n\t\t: ")
+		} else {
+			d.buffer.WriteString(",\n\t\t  ")
+		}
+		d.buffer.WriteString(SnakeToCamel(f.Name))
+		d.buffer.WriteString(" = ")
+		d.generateFromMapValue(&f)
+	}
+	d.buffer.WriteString(";\n\n")
+
+	d.buffer.WriteString("\t")
+	d.buffer.WriteString(object.Name)
+	d.buffer.WriteString(".fromJson(String source) : this.fromMap(jsonDecode(source));\n\n")
+
+	d.buffer.WriteString("}\n\n")
+}
+
+func (d *DartGenerator) generateFromMapValue(f *ObjectField) {
+	value := "map[" + dartQuote(JSONName(f)) + "]"
+	if IsPrimitiveType(&f.Type) && !d.needsDecoding(&f.Type) {
+		d.buffer.WriteString(value)
+		return
+	}
+
+	if IsOptionalField(f) {
+		d.buffer.WriteString(value)
+		d.buffer.WriteString(" == null ? null : ")
+	} else if _, entry := d.lookup(&f.Type); entry != nil && entry.Object != nil {
+		value += " ?? {}"
+	}
+
+	d.generateFromMapExpression(&f.Type, value)
+}
+
+// generateFromMapExpression converts expr, a value decoded by jsonDecode, to t.
+func (d *DartGenerator) generateFromMapExpression(t *Type, expr string) {
+	if t.PrimitiveType != nil {
+		switch t.PrimitiveType.Type {
+		case "float32", "float64":
+			d.buffer.WriteString("(")
+			d.buffer.WriteString(expr)
+			d.buffer.WriteString(" as num).toDouble()")
+		default:
+			d.buffer.WriteString(expr)
+		}
+	} else if t.ListType != nil {
+		d.generateType(t)
+		d.buffer.WriteString(".from(")
+		if !d.needsDecoding(&t.ListType.ElementType) {
+			d.buffer.WriteString(expr)
+		} else {
+			d.buffer.WriteString("(")
+			d.buffer.WriteString(expr)
+			d.buffer.WriteString(" as List).map((e) => ")
+			d.generateFromMapExpression(&t.ListType.ElementType, "e")
+			d.buffer.WriteString(")")
+		}
+		d.buffer.WriteString(")")
+	} else if t.MapType != nil {
+		d.generateType(t)
+		d.buffer.WriteString(".from(")
+		if t.MapType.KeyType.Type == "string" && !d.needsDecoding(&t.MapType.ValueType) {
+			d.buffer.WriteString(expr)
+		} else {
+			d.buffer.WriteString("(")
+			d.buffer.WriteString(expr)
+			d.buffer.WriteString(" as Map).map((k, v) => MapEntry(")
+			switch t.MapType.KeyType.Type {
+			case "string":
+				d.buffer.WriteString("k")
+			case "float32", "float64":
+				d.buffer.WriteString("double.parse(k)")
+			case "bool":
+				d.buffer.WriteString("k == \"true\"")
+			default:
+				d.buffer.WriteString("int.parse(k)")
+			}
+			d.buffer.WriteString(", ")
+			d.generateFromMapExpression(&t.MapType.ValueType, "v")
+			d.buffer.WriteString("))")
+		}
+		d.buffer.WriteString(")")
+	} else if t.Identity != nil {
+		d.buffer.WriteString(*t.Identity)
+		if _, entry := d.lookup(t); entry != nil && entry.Enum != nil {
+			d.buffer.WriteString(".fromValue(")
+		} else {
+			d.buffer.WriteString(".fromMap(")
+		}
+		d.buffer.WriteString(expr)
+		d.buffer.WriteString(")")
+	}
+}
+
+// generateToMapExpression converts expr of type t to a value jsonEncode accepts.
+// nullable expressions are only converted when they are not null.
+func (d *DartGenerator) generateToMapExpression(t *Type, expr string, nullable bool) {
+	access := "."
+	if nullable {
+		access = "?."
+	}
+
+	if t.PrimitiveType != nil {
+		d.buffer.WriteString(expr)
+	} else if t.ListType != nil {
+		d.buffer.WriteString(expr)
+		if d.needsEncoding(&t.ListType.ElementType) {
+			d.buffer.WriteString(access)
+			d.buffer.WriteString("map((e) => ")
+			d.generateToMapExpression(&t.ListType.ElementType, "e", false)
+			d.buffer.WriteString(").toList()")
+		}
+	} else if t.MapType != nil {
+		d.buffer.WriteString(expr)
+		if t.MapType.KeyType.Type != "string" || d.needsEncoding(&t.MapType.ValueType) {
+			d.buffer.WriteString(access)
+			d.buffer.WriteString("map((k, v) => MapEntry(")
+			if t.MapType.KeyType.Type == "string" {
+				d.buffer.WriteString("k")
+			} else {
+				d.buffer.WriteString("k.toString()")
+			}
+			d.buffer.WriteString(", ")
+			d.generateToMapExpression(&t.MapType.ValueType, "v", false)
+			d.buffer.WriteString("))")
+		}
+	} else if t.Identity != nil {
+		d.buffer.WriteString(expr)
+		d.buffer.WriteString(access)
+		if _, entry := d.lookup(t); entry != nil && entry.Enum != nil {
+			d.buffer.WriteString("value")
+		} else {
+			d.buffer.WriteString("toMap()")
+		}
+	}
+}
+
+// needsDecoding reports whether values of t decoded by jsonDecode must be converted before use.
+// Nested lists and maps are decoded as List<dynamic> and Map<String, dynamic>, so every level
+// needs its own typed copy.
+func (d *DartGenerator) needsDecoding(t *Type) bool {
+	if t.PrimitiveType != nil {
+		return t.PrimitiveType.Type == "float32" || t.PrimitiveType.Type == "float64"
+	}
+
+	return true
+}
+
+// needsEncoding reports whether values of t must be converted before jsonEncode accepts them.
+func (d *DartGenerator) needsEncoding(t *Type) bool {
+	if t.PrimitiveType != nil {
+		return false
+	} else if t.ListType != nil {
+		return d.needsEncoding(&t.ListType.ElementType)
+	} else if t.MapType != nil {
+		return t.MapType.KeyType.Type != "string" || d.needsEncoding(&t.MapType.ValueType)
+	}
+
+	return true
+}
+
+func (d *DartGenerator) lookup(t *Type) (*Grammar, *Entry) {
+	if t.Identity == nil {
+		return nil, nil
+	}
+
+	return LookupEntry(d.values, *t.Identity)
+}
+
+// dartQuote returns s as a Dart string literal, escaping the `$` of interpolations.
+func dartQuote(s string) string {
+	return strings.ReplaceAll(strconv.Quote(s), "$", "\\$")
+}
@@ -1,17 +1,23 @@
-package main
+package gidle
 
 import (
 	"bytes"
 	"errors"
 	"go/format"
-	"os"
 	"strconv"
+	"strings"
 
 	"golang.org/x/tools/imports"
 )
 
 type GoGenerator struct {
 	buffer *bytes.Buffer
+	values *Grammar
+
+	// PackageName overrides the last segment of the schema package as the Go package name.
+	PackageName string
+	// ModulePath prefixes the import path of imported schemas, which is their package joined by slashes.
+	ModulePath string
 }
 
 func NewGoGenerator() *GoGenerator {
@@ -20,16 +26,20 @@ func NewGoGenerator() *GoGenerator {
 	}
 }
 
-func (g *GoGenerator) Generate(outPath string, values *Grammar) error {
+func (g *GoGenerator) Generate(outPath string, values *Grammar) (Files, error) {
 	g.buffer.Reset()
+	g.values = values
+
+	packageName := values.Package.Names[len(values.Package.Names)-1]
+	if g.PackageName != "" {
+		packageName = g.PackageName
+	}
 
 	g.buffer.WriteString("package ")
-	g.buffer.WriteString(values.Package.Names[len(values.Package.Names)-1])
+	g.buffer.WriteString(packageName)
 	g.buffer.WriteString("\n\n")
 
-	// g.buffer.WriteString("import (\n")
-	// g.buffer.WriteString("\t\"errors\"\n")
-	// g.buffer.WriteString(")\n\n")
+	g.generateImports(values)
 
 	for _, entry := range values.Entries {
 		if entry.Const != nil {
@@ -43,24 +53,48 @@ func (g *GoGenerator) Generate(outPath string, values *Grammar) error {
 
 	formatted, err := format.Source(g.buffer.Bytes())
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	formatted, err = imports.Process("", formatted, nil)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	if err := os.WriteFile(outPath, formatted, 0644); err != nil {
-		return err
+	return Files{outPath: formatted}, nil
+}
+
+func (g *GoGenerator) generateImports(values *Grammar) {
+	if len(values.Imports) == 0 {
+		return
 	}
 
-	return nil
+	g.buffer.WriteString("import (\n")
+	for i := range values.Imports {
+		imp := &values.Imports[i]
+		if imp.Grammar == nil || IsSamePackage(values, imp.Grammar) {
+			continue
+		}
+
+		importPath := strings.Join(imp.Grammar.Package.Names, "/")
+		if g.ModulePath != "" {
+			importPath = g.ModulePath + "/" + importPath
+		}
+
+		g.buffer.WriteString("\t")
+		g.buffer.WriteString(ImportQualifier(imp))
+		g.buffer.WriteString(" ")
+		g.buffer.WriteString(strconv.Quote(importPath))
+		g.buffer.WriteString("\n")
+	}
+	g.buffer.WriteString(")\n\n")
 }
 
 func (g *GoGenerator) generateConst(constant *Const) error {
+	writeLineDoc(g.buffer, "", "// ", constant.Doc)
 	g.buffer.WriteString("const (\n")
 	for _, f := range constant.Fields {
+		writeLineDoc(g.buffer, "\t", "// ", f.Doc)
 		g.buffer.WriteString("\t")
 		g.buffer.WriteString(constant.Name)
 		g.buffer.WriteString("_")
@@ -75,6 +109,7 @@ func (g *GoGenerator) generateConst(constant *Const) error {
 }
 
 func (g *GoGenerator) generateEnum(enum *Enum) error {
+	writeLineDoc(g.buffer, "", "// ", enum.Doc)
 	g.buffer.WriteString("type ")
 	g.buffer.WriteString(enum.Name)
 	g.buffer.WriteString(" ")
@@ -83,6 +118,7 @@ func (g *GoGenerator) generateEnum(enum *Enum) error {
 
 	g.buffer.WriteString("const (\n")
 	for _, v := range enum.Body {
+		writeLineDoc(g.buffer, "\t", "// ", v.Doc)
 		g.buffer.WriteString("\t")
 		g.buffer.WriteString(enum.Name)
 		g.buffer.WriteString("_")
@@ -172,7 +208,7 @@ func (g *GoGenerator) generatePrimitiveValue(value *PrimitiveValue) error {
 	} else if value.FloatValue != nil {
 		g.buffer.WriteString(strconv.FormatFloat(*value.FloatValue, 'f', -1, 64))
 	} else if value.BoolValue != nil {
-		g.buffer.WriteString(strconv.FormatBool(*value.BoolValue))
+		g.buffer.WriteString(strconv.FormatBool(bool(*value.BoolValue)))
 	} else {
 		return errors.New("unknown primitive value")
 	}
@@ -188,7 +224,7 @@ func (g *GoGenerator) generateType(t *Type) error {
 	} else if t.MapType != nil {
 		g.generateMapType(t.MapType)
 	} else if t.Identity != nil {
-		g.buffer.WriteString(*t.Identity)
+		g.generateIdentity(*t.Identity)
 	} else {
 		return errors.New("unknown type")
 	}
@@ -196,6 +232,17 @@ func (g *GoGenerator) generateType(t *Type) error {
 	return nil
 }
 
+func (g *GoGenerator) generateIdentity(identity string) {
+	qualifier, name := SplitIdentity(identity)
+	if qualifier != "" {
+		if imp := LookupImport(g.values, qualifier); imp == nil || imp.Grammar == nil || !IsSamePackage(g.values, imp.Grammar) {
+			g.buffer.WriteString(qualifier)
+			g.buffer.WriteString(".")
+		}
+	}
+	g.buffer.WriteString(name)
+}
+
 func (g *GoGenerator) generatePrimitiveType(t *PrimitiveType) error {
 	g.buffer.WriteString(t.Type)
 
@@ -206,7 +253,7 @@ func (g *GoGenerator) generateMapType(m *MapType) error {
 	g.buffer.WriteString("map[")
 	g.generatePrimitiveType(&m.KeyType)
 	g.buffer.WriteString("]")
-	g.generatePrimitiveType(&m.ValueType)
+	g.generateType(&m.ValueType)
 
 	return nil
 }
@@ -219,18 +266,26 @@ func (g *GoGenerator) generateListType(list *ListType) error {
 }
 
 func (g *GoGenerator) generateObject(object *Object) error {
+	writeLineDoc(g.buffer, "", "// ", object.Doc)
 	g.buffer.WriteString("type ")
 	g.buffer.WriteString(object.Name)
 	g.buffer.WriteString(" struct {\n")
 	for _, f := range object.Fields {
+		writeLineDoc(g.buffer, "\t", "// ", f.Doc)
 		g.buffer.WriteString("\t")
 		g.buffer.WriteString(SnakeToPascal(f.Name))
 		g.buffer.WriteString(" ")
+		if IsOptionalField(&f) && !IsListType(&f.Type) && !IsMapType(&f.Type) {
+			g.buffer.WriteString("*")
+		}
 		g.generateType(&f.Type)
-		g.buffer.WriteString(" `")
-		g.buffer.WriteString("json:\"")
-		g.buffer.WriteString(f.Name)
-		g.buffer.WriteString("\"`")
+		tag := JSONName(&f)
+		if IsOptionalField(&f) {
+			tag += ",omitempty"
+		}
+		g.buffer.WriteString(" `json:")
+		g.buffer.WriteString(strconv.Quote(tag))
+		g.buffer.WriteString("`")
 		g.buffer.WriteString("\n")
 	}
 	g.buffer.WriteString("}\n\n")
@@ -0,0 +1,533 @@
+package gidle
+
+import (
+	"bytes"
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf16"
+)
+
+const (
+	JavaClassRecord = "record"
+	JavaClassPOJO   = "pojo"
+)
+
+var javaKeywords = map[string]bool{
+	"abstract": true, "assert": true, "boolean": true, "break": true, "byte": true, "case": true, "catch": true,
+	"char": true, "class": true, "const": true, "continue": true, "default": true, "do": true, "double": true,
+	"else": true, "enum": true, "extends": true, "false": true, "final": true, "finally": true, "float": true,
+	"for": true, "goto": true, "if": true, "implements": true, "import": true, "instanceof": true, "int": true,
+	"interface": true, "long": true, "native": true, "new": true, "null": true, "package": true, "private": true,
+	"protected": true, "public": true, "return": true, "short": true, "static": true, "strictfp": true,
+	"super": true, "switch": true, "synchronized": true, "this": true, "throw": true, "throws": true,
+	"transient": true, "true": true, "try": true, "void": true, "volatile": true, "while": true,
+}
+
+type JavaGenerator struct {
+	buffer  *bytes.Buffer
+	values  *Grammar
+	imports map[string]bool
+
+	// ClassStyle is JavaClassRecord to generate objects as records, or JavaClassPOJO for classes
+	// with getters and setters.
+	ClassStyle string
+}
+
+func NewJavaGenerator() *JavaGenerator {
+	return &JavaGenerator{
+		buffer:  bytes.NewBuffer(nil),
+		imports: map[string]bool{},
+	}
+}
+
+// Generate returns one file per const, enum and object in the directory outDir.
+func (j *JavaGenerator) Generate(outDir string, values *Grammar) (Files, error) {
+	j.values = values
+
+	files := make(Files)
+	for _, entry := range values.Entries {
+		j.buffer.Reset()
+		clear(j.imports)
+
+		var name string
+		if entry.Const != nil {
+			name = entry.Const.Name
+			j.generateConst(entry.Const)
+		} else if entry.Enum != nil {
+			name = entry.Enum.Name
+			j.generateEnum(entry.Enum)
+		} else if entry.Object != nil {
+			name = entry.Object.Name
+			if j.ClassStyle == JavaClassPOJO {
+				j.generateClass(entry.Object)
+			} else {
+				j.generateRecord(entry.Object)
+			}
+		} else {
+			continue
+		}
+
+		files[filepath.Join(outDir, name+".java")] = j.file()
+	}
+
+	return files, nil
+}
+
+// file returns the package declaration and the imports collected while generating the buffer, followed by the buffer.
+func (j *JavaGenerator) file() []byte {
+	file := bytes.NewBuffer(nil)
+	file.WriteString("package ")
+	file.WriteString(javaPackage(j.values.Package.Names))
+	file.WriteString(";\n\n")
+
+	imports := make([]string, 0, len(j.imports))
+	for imp := range j.imports {
+		imports = append(imports, imp)
+	}
+	sort.Strings(imports)
+	for _, imp := range imports {
+		file.WriteString("import ")
+		file.WriteString(imp)
+		file.WriteString(";\n")
+	}
+	if len(imports) > 0 {
+		file.WriteString("\n")
+	}
+
+	file.Write(j.buffer.Bytes())
+
+	return file.Bytes()
+}
+
+// generatePrimitiveValue writes value as a literal of the Java type of t.
+func (j *JavaGenerator) generatePrimitiveValue(value *PrimitiveValue, t *PrimitiveType) {
+	if value.StringValue != nil {
+		j.buffer.WriteString(javaQuote(*value.StringValue))
+	} else if value.BoolValue != nil {
+		j.buffer.WriteString(strconv.FormatBool(bool(*value.BoolValue)))
+	} else if value.IntValue != nil || value.FloatValue != nil {
+		var literal string
+		if value.IntValue != nil {
+			literal = strconv.FormatInt(*value.IntValue, 10)
+		} else {
+			literal = strconv.FormatFloat(*value.FloatValue, 'f', -1, 64)
+		}
+
+		switch t.Type {
+		case "int8":
+			j.buffer.WriteString("(byte) ")
+		case "int16", "uint8":
+			j.buffer.WriteString("(short) ")
+		case "int64", "uint32":
+			literal += "L"
+		case "uint64":
+			j.imports["java.math.BigInteger"] = true
+			literal = "new BigInteger(\"" + literal + "\")"
+		case "float32":
+			literal += "f"
+		case "float64":
+			if !strings.Contains(literal, ".") {
+				literal += ".0"
+			}
+		}
+		j.buffer.WriteString(literal)
+	} else {
+		j.buffer.WriteString("null")
+	}
+}
+
+func (j *JavaGenerator) generateConst(constant *Const) {
+	writeBlockDoc(j.buffer, "", constant.Doc)
+	j.buffer.WriteString("public final class ")
+	j.buffer.WriteString(constant.Name)
+	j.buffer.WriteString(" {\n")
+	for _, f := range constant.Fields {
+		writeBlockDoc(j.buffer, "    ", f.Doc)
+		j.buffer.WriteString("    public static final ")
+		j.generatePrimitiveType(&constant.Type)
+		j.buffer.WriteString(" ")
+		j.buffer.WriteString(javaName(f.Name))
+		j.buffer.WriteString(" = ")
+		j.generatePrimitiveValue(&f.Value, &constant.Type)
+		j.buffer.WriteString(";\n")
+	}
+	j.buffer.WriteString("\n")
+	j.buffer.WriteString("    private ")
+	j.buffer.WriteString(constant.Name)
+	j.buffer.WriteString("() {\n")
+	j.buffer.WriteString("    }\n")
+	j.buffer.WriteString("}\n")
+}
+
+func (j *JavaGenerator) generateEnum(enum *Enum) {
+	j.imports["com.fasterxml.jackson.annotation.JsonCreator"] = true
+	j.imports["com.fasterxml.jackson.annotation.JsonValue"] = true
+
+	writeBlockDoc(j.buffer, "", enum.Doc)
+	j.buffer.WriteString("public enum ")
+	j.buffer.WriteString(enum.Name)
+	j.buffer.WriteString(" {\n")
+	for i, v := range enum.Body {
+		writeBlockDoc(j.buffer, "    ", v.Doc)
+		j.buffer.WriteString("    ")
+		j.buffer.WriteString(javaName(v.Name))
+		j.buffer.WriteString("(")
+		j.generatePrimitiveValue(&v.Value, &enum.Type)
+		j.buffer.WriteString(")")
+		if i < len(enum.Body)-1 {
+			j.buffer.WriteString(",\n")
+		}
+	}
+	j.buffer.WriteString(";\n\n")
+
+	j.buffer.WriteString("    private final ")
+	j.generatePrimitiveType(&enum.Type)
+	j.buffer.WriteString(" value;\n\n")
+
+	j.buffer.WriteString("    ")
+	j.buffer.WriteString(enum.Name)
+	j.buffer.WriteString("(")
+	j.generatePrimitiveType(&enum.Type)
+	j.buffer.WriteString(" value) {\n")
+	j.buffer.WriteString("        this.value = value;\n")
+	j.buffer.WriteString("    }\n\n")
+
+	j.buffer.WriteString("    @JsonValue\n")
+	j.buffer.WriteString("    public ")
+	j.generatePrimitiveType(&enum.Type)
+	j.buffer.WriteString(" getValue() {\n")
+	j.buffer.WriteString("        return value;\n")
+	j.buffer.WriteString("    }\n\n")
+
+	j.buffer.WriteString("    @JsonCreator(mode = JsonCreator.Mode.DELEGATING)\n")
+	j.buffer.WriteString("    public static ")
+	j.buffer.WriteString(enum.Name)
+	j.buffer.WriteString(" fromValue(")
+	j.generatePrimitiveType(&enum.Type)
+	j.buffer.WriteString(" value) {\n")
+	j.buffer.WriteString("        for (")
+	j.buffer.WriteString(enum.Name)
+	j.buffer.WriteString(" v : values()) {\n")
+	if enum.Type.Type == "string" || enum.Type.Type == "uint64" {
+		j.buffer.WriteString("            if (v.value.equals(value)) {\n")
+	} else {
+		j.buffer.WriteString("            if (v.value == value) {\n")
+	}
+	j.buffer.WriteString("                return v;\n")
+	j.buffer.WriteString("            }\n")
+	j.buffer.WriteString("        }\n")
+	j.buffer.WriteString("        throw new IllegalArgumentException(\"unknown ")
+	j.buffer.WriteString(enum.Name)
+	j.buffer.WriteString(" value \" + value);\n")
+	j.buffer.WriteString("    }\n")
+	j.buffer.WriteString("}\n")
+}
+
+// generateType writes t as a reference type, which generic arguments and nullable fields need.
+func (j *JavaGenerator) generateType(t *Type) {
+	if t.PrimitiveType != nil {
+		j.generateBoxedType(t.PrimitiveType)
+	} else if t.ListType != nil {
+		j.imports["java.util.List"] = true
+		j.buffer.WriteString("List<")
+		j.generateType(&t.ListType.ElementType)
+		j.buffer.WriteString(">")
+	} else if t.MapType != nil {
+		j.imports["java.util.Map"] = true
+		j.buffer.WriteString("Map<")
+		j.generateBoxedType(&t.MapType.KeyType)
+		j.buffer.WriteString(", ")
+		j.generateType(&t.MapType.ValueType)
+		j.buffer.WriteString(">")
+	} else if t.Identity != nil {
+		j.generateIdentity(*t.Identity)
+	}
+}
+
+// generateFieldType writes the type of f, using primitive types for required primitive fields.
+func (j *JavaGenerator) generateFieldType(f *ObjectField) {
+	if f.Type.PrimitiveType != nil && !IsOptionalField(f) {
+		j.generatePrimitiveType(f.Type.PrimitiveType)
+	} else {
+		j.generateType(&f.Type)
+	}
+}
+
+// generateIdentity writes a type reference, fully qualified when it is imported from another package.
+func (j *JavaGenerator) generateIdentity(identity string) {
+	qualifier, name := SplitIdentity(identity)
+	if qualifier != "" {
+		if imp := LookupImport(j.values, qualifier); imp != nil && imp.Grammar != nil && !IsSamePackage(j.values, imp.Grammar) {
+			j.buffer.WriteString(javaPackage(imp.Grammar.Package.Names))
+			j.buffer.WriteString(".")
+		}
+	}
+	j.buffer.WriteString(name)
+}
+
+// generatePrimitiveType writes t as a Java primitive type. Unsigned integers use the next wider signed type.
+func (j *JavaGenerator) generatePrimitiveType(t *PrimitiveType) {
+	switch t.Type {
+	case "int8":
+		j.buffer.WriteString("byte")
+	case "int16", "uint8":
+		j.buffer.WriteString("short")
+	case "int32", "uint16":
+		j.buffer.WriteString("int")
+	case "int64", "uint32":
+		j.buffer.WriteString("long")
+	case "float32":
+		j.buffer.WriteString("float")
+	case "float64":
+		j.buffer.WriteString("double")
+	case "bool":
+		j.buffer.WriteString("boolean")
+	default:
+		j.generateBoxedType(t)
+	}
+}
+
+func (j *JavaGenerator) generateBoxedType(t *PrimitiveType) {
+	switch t.Type {
+	case "int8":
+		j.buffer.WriteString("Byte")
+	case "int16", "uint8":
+		j.buffer.WriteString("Short")
+	case "int32", "uint16":
+		j.buffer.WriteString("Integer")
+	case "int64", "uint32":
+		j.buffer.WriteString("Long")
+	case "uint64":
+		j.imports["java.math.BigInteger"] = true
+		j.buffer.WriteString("BigInteger")
+	case "float32":
+		j.buffer.WriteString("Float")
+	case "float64":
+		j.buffer.WriteString("Double")
+	case "bool":
+		j.buffer.WriteString("Boolean")
+	default:
+		j.buffer.WriteString("String")
+	}
+}
+
+func (j *JavaGenerator) generateFieldAnnotations(f *ObjectField) {
+	j.imports["com.fasterxml.jackson.annotation.JsonProperty"] = true
+
+	j.buffer.WriteString("@JsonProperty(")
+	j.buffer.WriteString(javaQuote(JSONName(f)))
+	j.buffer.WriteString(")")
+	if IsOptionalField(f) {
+		j.imports["com.fasterxml.jackson.annotation.JsonInclude"] = true
+		j.buffer.WriteString(" @JsonInclude(JsonInclude.Include.NON_NULL)")
+	}
+}
+
+func (j *JavaGenerator) generateRecord(object *Object) {
+	// Javadoc documents record components with @param tags on the record.
+	var params Doc
+	for _, f := range object.Fields {
+		if len(f.Doc) > 0 {
+			params = append(params, "@param "+javaName(SnakeToCamel(f.Name))+" "+f.Doc[0])
+			params = append(params, f.Doc[1:]...)
+		}
+	}
+	writeBlockDoc(j.buffer, "", joinDoc(object.Doc, params))
+	j.buffer.WriteString("public record ")
+	j.buffer.WriteString(object.Name)
+	j.buffer.WriteString("(")
+	for i, f := range object.Fields {
+		if i > 0 {
+			j.buffer.WriteString(",")
+		}
+		j.buffer.WriteString("\n        ")
+		j.generateFieldAnnotations(&f)
+		j.buffer.WriteString(" ")
+		j.generateFieldType(&f)
+		j.buffer.WriteString(" ")
+		j.buffer.WriteString(javaName(SnakeToCamel(f.Name)))
+	}
+	j.buffer.WriteString(") {\n")
+	j.buffer.WriteString("}\n")
+}
+
+func (j *JavaGenerator) generateClass(object *Object) {
+	j.imports["java.util.Objects"] = true
+
+	writeBlockDoc(j.buffer, "", object.Doc)
+	j.buffer.WriteString("public class ")
+	j.buffer.WriteString(object.Name)
+	j.buffer.WriteString(" {\n")
+	for _, f := range object.Fields {
+		writeBlockDoc(j.buffer, "    ", f.Doc)
+		j.buffer.WriteString("    ")
+		j.generateFieldAnnotations(&f)
+		j.buffer.WriteString("\n")
+		j.buffer.WriteString("    private ")
+		j.generateFieldType(&f)
+		j.buffer.WriteString(" ")
+		j.buffer.WriteString(javaName(SnakeToCamel(f.Name)))
+		j.buffer.WriteString(";\n")
+	}
+	if len(object.Fields) > 0 {
+		j.buffer.WriteString("\n")
+	}
+
+	j.buffer.WriteString("    public ")
+	j.buffer.WriteString(object.Name)
+	j.buffer.WriteString("() {\n")
+	j.buffer.WriteString("    }\n")
+
+	if len(object.Fields) > 0 {
+		j.buffer.WriteString("\n")
+		j.buffer.WriteString("    public ")
+		j.buffer.WriteString(object.Name)
+		j.buffer.WriteString("(")
+		for i, f := range object.Fields {
+			if i > 0 {
+				j.buffer.WriteString(", ")
+			}
+			j.generateFieldType(&f)
+			j.buffer.WriteString(" ")
+			j.buffer.WriteString(javaName(SnakeToCamel(f.Name)))
+		}
+		j.buffer.WriteString(") {\n")
+		for _, f := range object.Fields {
+			name := javaName(SnakeToCamel(f.Name))
+			j.buffer.WriteString("        this.")
+			j.buffer.WriteString(name)
+			j.buffer.WriteString(" = ")
+			j.buffer.WriteString(name)
+			j.buffer.WriteString(";\n")
+		}
+		j.buffer.WriteString("    }\n")
+	}
+
+	for _, f := range object.Fields {
+		name := javaName(SnakeToCamel(f.Name))
+		accessor := SnakeToPascal(f.Name)
+
+		j.buffer.WriteString("\n")
+		j.buffer.WriteString("    public ")
+		j.generateFieldType(&f)
+		j.buffer.WriteString(" get")
+		j.buffer.WriteString(accessor)
+		j.buffer.WriteString("() {\n")
+		j.buffer.WriteString("        return ")
+		j.buffer.WriteString(name)
+		j.buffer.WriteString(";\n")
+		j.buffer.WriteString("    }\n\n")
+
+		j.buffer.WriteString("    public void set")
+		j.buffer.WriteString(accessor)
+		j.buffer.WriteString("(")
+		j.generateFieldType(&f)
+		j.buffer.WriteString(" ")
+		j.buffer.WriteString(name)
+		j.buffer.WriteString(") {\n")
+		j.buffer.WriteString("        this.")
+		j.buffer.WriteString(name)
+		j.buffer.WriteString(" = ")
+		j.buffer.WriteString(name)
+		j.buffer.WriteString(";\n")
+		j.buffer.WriteString("    }\n")
+	}
+
+	j.buffer.WriteString("\n")
+	j.buffer.WriteString("    @Override\n")
+	j.buffer.WriteString("    public boolean equals(Object o) {\n")
+	j.buffer.WriteString("        if (this == o) {\n")
+	j.buffer.WriteString("            return true;\n")
+	j.buffer.WriteString("        }\n")
+	j.buffer.WriteString("        if (!(o instanceof ")
+	j.buffer.WriteString(object.Name)
+	j.buffer.WriteString(" other)) {\n")
+	j.buffer.WriteString("            return false;\n")
+	j.buffer.WriteString("        }\n")
+	j.buffer.WriteString("        return ")
+	if len(object.Fields) == 0 {
+		j.buffer.WriteString("true")
+	}
+	for i, f := range object.Fields {
+		name := javaName(SnakeToCamel(f.Name))
+		if i > 0 {
+			j.buffer.WriteString("\n            && ")
+		}
+		j.buffer.WriteString("Objects.equals(")
+		j.buffer.WriteString(name)
+		j.buffer.WriteString(", other.")
+		j.buffer.WriteString(name)
+		j.buffer.WriteString(")")
+	}
+	j.buffer.WriteString(";\n")
+	j.buffer.WriteString("    }\n\n")
+
+	j.buffer.WriteString("    @Override\n")
+	j.buffer.WriteString("    public int hashCode() {\n")
+	j.buffer.WriteString("        return Objects.hash(")
+	for i, f := range object.Fields {
+		if i > 0 {
+			j.buffer.WriteString(", ")
+		}
+		j.buffer.WriteString(javaName(SnakeToCamel(f.Name)))
+	}
+	j.buffer.WriteString(");\n")
+	j.buffer.WriteString("    }\n")
+	j.buffer.WriteString("}\n")
+}
+
+func javaPackage(names []string) string {
+	escaped := make([]string, 0, len(names))
+	for _, name := range names {
+		escaped = append(escaped, javaName(name))
+	}
+
+	return strings.Join(escaped, ".")
+}
+
+// javaName appends an underscore to Java keywords, which cannot be escaped.
+func javaName(name string) string {
+	if javaKeywords[name] {
+		return name + "_"
+	}
+
+	return name
+}
+
+// javaQuote returns s as a Java string literal. Control characters use octal escapes
+// because unicode escapes are translated before the literal is lexed.
+func javaQuote(s string) string {
+	sb := strings.Builder{}
+	sb.WriteByte('"')
+	for _, r := range s {
+		switch r {
+		case '"':
+			sb.WriteString("\\\"")
+		case '\\':
+			sb.WriteString("\\\\")
+		case '\n':
+			sb.WriteString("\\n")
+		case '\r':
+			sb.WriteString("\\r")
+		case '\t':
+			sb.WriteString("\\t")
+		default:
+			if unicode.IsPrint(r) {
+				sb.WriteRune(r)
+			} else if r < 0x100 {
+				sb.WriteString(fmt.Sprintf("\\%03o", r))
+			} else {
+				for _, u := range utf16.Encode([]rune{r}) {
+					sb.WriteString(fmt.Sprintf("\\u%04x", u))
+				}
+			}
+		}
+	}
+	sb.WriteByte('"')
+
+	return sb.String()
+}
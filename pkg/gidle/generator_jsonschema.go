@@ -0,0 +1,235 @@
+package gidle
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+)
+
+const JSONSchemaDraft = "https://json-schema.org/draft/2020-12/schema"
+
+type jsonSchemaMember struct {
+	Key   string
+	Value any
+}
+
+// jsonSchemaObject is a JSON object that keeps its members in insertion order.
+type jsonSchemaObject []jsonSchemaMember
+
+func (o jsonSchemaObject) MarshalJSON() ([]byte, error) {
+	buffer := bytes.NewBufferString("{")
+	for i, m := range o {
+		if i > 0 {
+			buffer.WriteString(",")
+		}
+
+		key, err := json.Marshal(m.Key)
+		if err != nil {
+			return nil, err
+		}
+		buffer.Write(key)
+		buffer.WriteString(":")
+
+		value, err := json.Marshal(m.Value)
+		if err != nil {
+			return nil, err
+		}
+		buffer.Write(value)
+	}
+	buffer.WriteString("}")
+
+	return buffer.Bytes(), nil
+}
+
+type JSONSchemaGenerator struct {
+	buffer *bytes.Buffer
+	values *Grammar
+}
+
+func NewJSONSchemaGenerator() *JSONSchemaGenerator {
+	return &JSONSchemaGenerator{
+		buffer: bytes.NewBuffer(nil),
+	}
+}
+
+func (j *JSONSchemaGenerator) Generate(outPath string, values *Grammar) (Files, error) {
+	j.buffer.Reset()
+	j.values = values
+
+	defs := jsonSchemaObject{}
+	for _, entry := range values.Entries {
+		if entry.Const != nil {
+			defs = append(defs, j.generateConst(entry.Const)...)
+		} else if entry.Enum != nil {
+			defs = append(defs, jsonSchemaMember{entry.Enum.Name, j.generateEnum(entry.Enum)})
+		} else if entry.Object != nil {
+			defs = append(defs, jsonSchemaMember{entry.Object.Name, j.generateObject(entry.Object)})
+		}
+	}
+
+	document := jsonSchemaObject{
+		{"$schema", JSONSchemaDraft},
+		{"title", strings.Join(values.Package.Names, ".")},
+		{"$defs", defs},
+	}
+
+	data, err := json.MarshalIndent(document, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	j.buffer.Write(data)
+	j.buffer.WriteString("\n")
+
+	return Files{outPath: bytes.Clone(j.buffer.Bytes())}, nil
+}
+
+func (j *JSONSchemaGenerator) generatePrimitiveValue(value *PrimitiveValue) any {
+	if value.StringValue != nil {
+		return *value.StringValue
+	} else if value.IntValue != nil {
+		return *value.IntValue
+	} else if value.FloatValue != nil {
+		return *value.FloatValue
+	} else if value.BoolValue != nil {
+		return bool(*value.BoolValue)
+	}
+
+	return nil
+}
+
+func (j *JSONSchemaGenerator) generateConst(constant *Const) []jsonSchemaMember {
+	members := make([]jsonSchemaMember, 0, len(constant.Fields))
+	for _, f := range constant.Fields {
+		schema := j.generatePrimitiveType(&constant.Type)
+		schema = appendDescription(schema, joinDoc(constant.Doc, f.Doc))
+		schema = append(schema, jsonSchemaMember{"const", j.generatePrimitiveValue(&f.Value)})
+		members = append(members, jsonSchemaMember{constant.Name + "_" + f.Name, schema})
+	}
+
+	return members
+}
+
+func (j *JSONSchemaGenerator) generateEnum(enum *Enum) jsonSchemaObject {
+	values := make([]any, 0, len(enum.Body))
+	for _, v := range enum.Body {
+		values = append(values, j.generatePrimitiveValue(&v.Value))
+	}
+
+	schema := j.generatePrimitiveType(&enum.Type)
+	schema = appendDescription(schema, enumDoc(enum))
+	schema = append(schema, jsonSchemaMember{"enum", values})
+
+	return schema
+}
+
+func (j *JSONSchemaGenerator) generateObject(object *Object) jsonSchemaObject {
+	properties := jsonSchemaObject{}
+	required := []string{}
+	for _, f := range object.Fields {
+		schema := j.generateType(&f.Type)
+		if IsOptionalField(&f) {
+			schema = jsonSchemaObject{{"anyOf", []any{schema, jsonSchemaObject{{"type", "null"}}}}}
+		} else {
+			required = append(required, JSONName(&f))
+		}
+		properties = append(properties, jsonSchemaMember{JSONName(&f), appendDescription(schema, f.Doc)})
+	}
+
+	schema := appendDescription(jsonSchemaObject{{"type", "object"}}, object.Doc)
+	return append(schema,
+		jsonSchemaMember{"properties", properties},
+		jsonSchemaMember{"required", required},
+	)
+}
+
+func (j *JSONSchemaGenerator) generateType(t *Type) jsonSchemaObject {
+	if t.PrimitiveType != nil {
+		return j.generatePrimitiveType(t.PrimitiveType)
+	} else if t.ListType != nil {
+		return jsonSchemaObject{
+			{"type", "array"},
+			{"items", j.generateType(&t.ListType.ElementType)},
+		}
+	} else if t.MapType != nil {
+		schema := jsonSchemaObject{{"type", "object"}}
+		if names := j.generatePropertyNames(&t.MapType.KeyType); names != nil {
+			schema = append(schema, jsonSchemaMember{"propertyNames", names})
+		}
+		return append(schema, jsonSchemaMember{"additionalProperties", j.generateType(&t.MapType.ValueType)})
+	} else if t.Identity != nil {
+		return jsonSchemaObject{{"$ref", j.generateReference(*t.Identity)}}
+	}
+
+	return jsonSchemaObject{}
+}
+
+func (j *JSONSchemaGenerator) generateReference(identity string) string {
+	qualifier, name := SplitIdentity(identity)
+	if qualifier == "" {
+		return "#/$defs/" + name
+	}
+
+	imp := LookupImport(j.values, qualifier)
+	if imp == nil {
+		return "#/$defs/" + name
+	}
+
+	return ImportOutputPath(imp, LanguageExtension(LanguageJSONSchema)) + "#/$defs/" + name
+}
+
+func (j *JSONSchemaGenerator) generatePrimitiveType(t *PrimitiveType) jsonSchemaObject {
+	switch t.Type {
+	case "int8", "int16", "int32", "int64", "uint8", "uint16", "uint32", "uint64":
+		min, max := integerRange(t.Type)
+		return jsonSchemaObject{
+			{"type", "integer"},
+			{"minimum", min},
+			{"maximum", max},
+		}
+	case "float32", "float64":
+		return jsonSchemaObject{{"type", "number"}}
+	case "bool":
+		return jsonSchemaObject{{"type", "boolean"}}
+	default:
+		return jsonSchemaObject{{"type", "string"}}
+	}
+}
+
+// generatePropertyNames returns the schema object keys of a map keyed by t must match,
+// or nil for string keys.
+func (j *JSONSchemaGenerator) generatePropertyNames(t *PrimitiveType) jsonSchemaObject {
+	switch t.Type {
+	case "int8", "int16", "int32", "int64":
+		return jsonSchemaObject{{"pattern", "^-?[0-9]+$"}}
+	case "uint8", "uint16", "uint32", "uint64":
+		return jsonSchemaObject{{"pattern", "^[0-9]+$"}}
+	case "float32", "float64":
+		return jsonSchemaObject{{"pattern", "^-?[0-9]+(\\.[0-9]+)?([eE][-+]?[0-9]+)?$"}}
+	case "bool":
+		return jsonSchemaObject{{"enum", []string{"true", "false"}}}
+	default:
+		return nil
+	}
+}
+
+// appendDescription adds doc to schema as its description, unless doc is empty.
+func appendDescription(schema jsonSchemaObject, doc Doc) jsonSchemaObject {
+	if len(doc) == 0 {
+		return schema
+	}
+
+	return append(schema, jsonSchemaMember{"description", strings.Join(doc, "\n")})
+}
+
+// enumDoc returns the doc of enum followed by a line for each documented value,
+// since JSON Schema cannot describe the members of `enum` one by one.
+func enumDoc(enum *Enum) Doc {
+	var values Doc
+	for _, v := range enum.Body {
+		if len(v.Doc) > 0 {
+			values = append(values, "- "+primitiveValueString(&v.Value)+" ("+v.Name+"): "+strings.Join(v.Doc, " "))
+		}
+	}
+
+	return joinDoc(enum.Doc, values)
+}
@@ -0,0 +1,326 @@
+package gidle
+
+import (
+	"bytes"
+	"strconv"
+	"strings"
+)
+
+var kotlinKeywords = map[string]bool{
+	"as": true, "break": true, "class": true, "continue": true, "do": true, "else": true, "false": true,
+	"for": true, "fun": true, "if": true, "in": true, "interface": true, "is": true, "null": true,
+	"object": true, "package": true, "return": true, "super": true, "this": true, "throw": true,
+	"true": true, "try": true, "typealias": true, "typeof": true, "val": true, "var": true, "when": true,
+	"while": true,
+}
+
+type KotlinGenerator struct {
+	buffer *bytes.Buffer
+	values *Grammar
+}
+
+func NewKotlinGenerator() *KotlinGenerator {
+	return &KotlinGenerator{
+		buffer: bytes.NewBuffer(nil),
+	}
+}
+
+func (k *KotlinGenerator) Generate(outPath string, values *Grammar) (Files, error) {
+	k.buffer.Reset()
+	k.values = values
+
+	k.buffer.WriteString("package ")
+	k.buffer.WriteString(kotlinPackage(values.Package.Names))
+	k.buffer.WriteString("\n\n")
+
+	hasEnum := false
+	for _, entry := range values.Entries {
+		if entry.Enum != nil {
+			hasEnum = true
+		}
+	}
+
+	if hasEnum {
+		k.buffer.WriteString("import kotlinx.serialization.KSerializer\n")
+	}
+	k.buffer.WriteString("import kotlinx.serialization.SerialName\n")
+	k.buffer.WriteString("import kotlinx.serialization.Serializable\n")
+	if hasEnum {
+		k.buffer.WriteString("import kotlinx.serialization.descriptors.PrimitiveKind\n")
+		k.buffer.WriteString("import kotlinx.serialization.descriptors.PrimitiveSerialDescriptor\n")
+		k.buffer.WriteString("import kotlinx.serialization.descriptors.SerialDescriptor\n")
+		k.buffer.WriteString("import kotlinx.serialization.encoding.Decoder\n")
+		k.buffer.WriteString("import kotlinx.serialization.encoding.Encoder\n")
+	}
+	k.buffer.WriteString("\n")
+
+	for _, entry := range values.Entries {
+		if entry.Const != nil {
+			k.generateConst(entry.Const)
+		} else if entry.Enum != nil {
+			k.generateEnum(entry.Enum)
+		} else if entry.Object != nil {
+			k.generateObject(entry.Object)
+		}
+	}
+
+	return Files{outPath: bytes.Clone(k.buffer.Bytes())}, nil
+}
+
+// generatePrimitiveValue writes value as a literal of the Kotlin type of t.
+func (k *KotlinGenerator) generatePrimitiveValue(value *PrimitiveValue, t *PrimitiveType) {
+	if value.StringValue != nil {
+		k.buffer.WriteString(kotlinQuote(*value.StringValue))
+	} else if value.BoolValue != nil {
+		k.buffer.WriteString(strconv.FormatBool(bool(*value.BoolValue)))
+	} else if value.IntValue != nil || value.FloatValue != nil {
+		var literal string
+		if value.IntValue != nil {
+			literal = strconv.FormatInt(*value.IntValue, 10)
+		} else {
+			literal = strconv.FormatFloat(*value.FloatValue, 'f', -1, 64)
+		}
+
+		switch t.Type {
+		case "uint8", "uint16", "uint32":
+			literal += "u"
+		case "uint64":
+			literal += "uL"
+		case "int64":
+			literal += "L"
+		case "float32":
+			literal += "f"
+		case "float64":
+			if !strings.Contains(literal, ".") {
+				literal += ".0"
+			}
+		}
+		k.buffer.WriteString(literal)
+	} else {
+		k.buffer.WriteString("null")
+	}
+}
+
+func (k *KotlinGenerator) generateConst(constant *Const) {
+	writeBlockDoc(k.buffer, "", constant.Doc)
+	k.buffer.WriteString("object ")
+	k.buffer.WriteString(constant.Name)
+	k.buffer.WriteString(" {\n")
+	for _, f := range constant.Fields {
+		writeBlockDoc(k.buffer, "    ", f.Doc)
+		k.buffer.WriteString("    const val ")
+		k.buffer.WriteString(kotlinName(f.Name))
+		k.buffer.WriteString(": ")
+		k.generatePrimitiveType(&constant.Type)
+		k.buffer.WriteString(" = ")
+		k.generatePrimitiveValue(&f.Value, &constant.Type)
+		k.buffer.WriteString("\n")
+	}
+	k.buffer.WriteString("}\n\n")
+}
+
+func (k *KotlinGenerator) generateEnum(enum *Enum) {
+	serializer := enum.Name + "Serializer"
+
+	writeBlockDoc(k.buffer, "", enum.Doc)
+	k.buffer.WriteString("@Serializable(with = ")
+	k.buffer.WriteString(serializer)
+	k.buffer.WriteString("::class)\n")
+	k.buffer.WriteString("enum class ")
+	k.buffer.WriteString(enum.Name)
+	k.buffer.WriteString("(val value: ")
+	k.generatePrimitiveType(&enum.Type)
+	k.buffer.WriteString(") {\n")
+	for i, v := range enum.Body {
+		writeBlockDoc(k.buffer, "    ", v.Doc)
+		k.buffer.WriteString("    ")
+		k.buffer.WriteString(kotlinName(v.Name))
+		k.buffer.WriteString("(")
+		k.generatePrimitiveValue(&v.Value, &enum.Type)
+		k.buffer.WriteString(")")
+		if i < len(enum.Body)-1 {
+			k.buffer.WriteString(",\n")
+		}
+	}
+	k.buffer.WriteString(";\n\n")
+
+	k.buffer.WriteString("    companion object {\n")
+	k.buffer.WriteString("        fun fromValue(value: ")
+	k.generatePrimitiveType(&enum.Type)
+	k.buffer.WriteString("): ")
+	k.buffer.WriteString(enum.Name)
+	k.buffer.WriteString(" =\n")
+	k.buffer.WriteString("            values().firstOrNull { it.value == value }\n")
+	k.buffer.WriteString("                ?: throw IllegalArgumentException(\"unknown ")
+	k.buffer.WriteString(enum.Name)
+	k.buffer.WriteString(" value $value\")\n")
+	k.buffer.WriteString("    }\n")
+	k.buffer.WriteString("}\n\n")
+
+	kind, encode, decode := kotlinEncoding(enum.Type.Type)
+
+	k.buffer.WriteString("object ")
+	k.buffer.WriteString(serializer)
+	k.buffer.WriteString(" : KSerializer<")
+	k.buffer.WriteString(enum.Name)
+	k.buffer.WriteString("> {\n")
+	k.buffer.WriteString("    override val descriptor: SerialDescriptor =\n")
+	k.buffer.WriteString("        PrimitiveSerialDescriptor(\"")
+	k.buffer.WriteString(strings.Join(k.values.Package.Names, "."))
+	k.buffer.WriteString(".")
+	k.buffer.WriteString(enum.Name)
+	k.buffer.WriteString("\", PrimitiveKind.")
+	k.buffer.WriteString(kind)
+	k.buffer.WriteString(")\n\n")
+	k.buffer.WriteString("    override fun serialize(encoder: Encoder, value: ")
+	k.buffer.WriteString(enum.Name)
+	k.buffer.WriteString(") =\n")
+	k.buffer.WriteString("        encoder.")
+	k.buffer.WriteString(strings.ReplaceAll(encode, "%s", "value.value"))
+	k.buffer.WriteString("\n\n")
+	k.buffer.WriteString("    override fun deserialize(decoder: Decoder): ")
+	k.buffer.WriteString(enum.Name)
+	k.buffer.WriteString(" =\n")
+	k.buffer.WriteString("        ")
+	k.buffer.WriteString(enum.Name)
+	k.buffer.WriteString(".fromValue(decoder.")
+	k.buffer.WriteString(decode)
+	k.buffer.WriteString(")\n")
+	k.buffer.WriteString("}\n\n")
+}
+
+func (k *KotlinGenerator) generateType(t *Type) {
+	if t.PrimitiveType != nil {
+		k.generatePrimitiveType(t.PrimitiveType)
+	} else if t.ListType != nil {
+		k.buffer.WriteString("List<")
+		k.generateType(&t.ListType.ElementType)
+		k.buffer.WriteString(">")
+	} else if t.MapType != nil {
+		k.buffer.WriteString("Map<")
+		k.generatePrimitiveType(&t.MapType.KeyType)
+		k.buffer.WriteString(", ")
+		k.generateType(&t.MapType.ValueType)
+		k.buffer.WriteString(">")
+	} else if t.Identity != nil {
+		k.generateIdentity(*t.Identity)
+	}
+}
+
+// generateIdentity writes a type reference, fully qualified when it is imported
+// because Kotlin has no package aliases.
+func (k *KotlinGenerator) generateIdentity(identity string) {
+	qualifier, name := SplitIdentity(identity)
+	if qualifier != "" {
+		if imp := LookupImport(k.values, qualifier); imp != nil && imp.Grammar != nil {
+			k.buffer.WriteString(kotlinPackage(imp.Grammar.Package.Names))
+			k.buffer.WriteString(".")
+		}
+	}
+	k.buffer.WriteString(name)
+}
+
+func (k *KotlinGenerator) generatePrimitiveType(t *PrimitiveType) {
+	switch t.Type {
+	case "int8":
+		k.buffer.WriteString("Byte")
+	case "int16":
+		k.buffer.WriteString("Short")
+	case "int32":
+		k.buffer.WriteString("Int")
+	case "int64":
+		k.buffer.WriteString("Long")
+	case "uint8":
+		k.buffer.WriteString("UByte")
+	case "uint16":
+		k.buffer.WriteString("UShort")
+	case "uint32":
+		k.buffer.WriteString("UInt")
+	case "uint64":
+		k.buffer.WriteString("ULong")
+	case "float32":
+		k.buffer.WriteString("Float")
+	case "float64":
+		k.buffer.WriteString("Double")
+	case "bool":
+		k.buffer.WriteString("Boolean")
+	default:
+		k.buffer.WriteString("String")
+	}
+}
+
+func (k *KotlinGenerator) generateObject(object *Object) {
+	writeBlockDoc(k.buffer, "", object.Doc)
+	k.buffer.WriteString("@Serializable\n")
+	k.buffer.WriteString("data class ")
+	k.buffer.WriteString(object.Name)
+	k.buffer.WriteString("(\n")
+	for _, f := range object.Fields {
+		writeBlockDoc(k.buffer, "    ", f.Doc)
+		k.buffer.WriteString("    @SerialName(")
+		k.buffer.WriteString(kotlinQuote(JSONName(&f)))
+		k.buffer.WriteString(")\n")
+		k.buffer.WriteString("    val ")
+		k.buffer.WriteString(kotlinName(SnakeToCamel(f.Name)))
+		k.buffer.WriteString(": ")
+		k.generateType(&f.Type)
+		if IsOptionalField(&f) {
+			k.buffer.WriteString("? = null")
+		}
+		k.buffer.WriteString(",\n")
+	}
+	k.buffer.WriteString(")\n\n")
+}
+
+// kotlinEncoding returns the descriptor kind of t and the Encoder and Decoder calls for it.
+// Unsigned values are carried in the next wider signed type.
+func kotlinEncoding(t string) (kind string, encode string, decode string) {
+	switch t {
+	case "int8":
+		return "BYTE", "encodeByte(%s)", "decodeByte()"
+	case "int16":
+		return "SHORT", "encodeShort(%s)", "decodeShort()"
+	case "int32":
+		return "INT", "encodeInt(%s)", "decodeInt()"
+	case "int64":
+		return "LONG", "encodeLong(%s)", "decodeLong()"
+	case "uint8":
+		return "SHORT", "encodeShort(%s.toShort())", "decodeShort().toUByte()"
+	case "uint16":
+		return "INT", "encodeInt(%s.toInt())", "decodeInt().toUShort()"
+	case "uint32":
+		return "LONG", "encodeLong(%s.toLong())", "decodeLong().toUInt()"
+	case "uint64":
+		return "LONG", "encodeLong(%s.toLong())", "decodeLong().toULong()"
+	case "float32":
+		return "FLOAT", "encodeFloat(%s)", "decodeFloat()"
+	case "float64":
+		return "DOUBLE", "encodeDouble(%s)", "decodeDouble()"
+	case "bool":
+		return "BOOLEAN", "encodeBoolean(%s)", "decodeBoolean()"
+	default:
+		return "STRING", "encodeString(%s)", "decodeString()"
+	}
+}
+
+func kotlinPackage(names []string) string {
+	escaped := make([]string, 0, len(names))
+	for _, name := range names {
+		escaped = append(escaped, kotlinName(name))
+	}
+
+	return strings.Join(escaped, ".")
+}
+
+// kotlinQuote returns s as a Kotlin string literal, escaping the `$` of string templates.
+func kotlinQuote(s string) string {
+	return strings.ReplaceAll(strconv.Quote(s), "$", "\\$")
+}
+
+func kotlinName(name string) string {
+	if kotlinKeywords[name] {
+		return "`" + name + "`"
+	}
+
+	return name
+}
@@ -0,0 +1,290 @@
+package gidle
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/alecthomas/participle/v2/lexer"
+)
+
+const (
+	ProtoMaxFieldNumber         = 536870911
+	ProtoReservedFieldNumberMin = 19000
+	ProtoReservedFieldNumberMax = 19999
+)
+
+type ProtoGenerator struct {
+	buffer *bytes.Buffer
+	values *Grammar
+	errors []error
+}
+
+func NewProtoGenerator() *ProtoGenerator {
+	return &ProtoGenerator{
+		buffer: bytes.NewBuffer(nil),
+	}
+}
+
+func (p *ProtoGenerator) Generate(outPath string, values *Grammar) (Files, error) {
+	p.buffer.Reset()
+	p.values = values
+	p.errors = nil
+
+	p.buffer.WriteString("syntax = \"proto3\";\n\n")
+	p.buffer.WriteString("package ")
+	p.buffer.WriteString(strings.Join(values.Package.Names, "."))
+	p.buffer.WriteString(";\n\n")
+
+	// A file imported twice under different names is imported once, protoc rejects duplicates.
+	imported := make(map[string]bool, len(values.Imports))
+	for i := range values.Imports {
+		importPath := ImportOutputPath(&values.Imports[i], LanguageExtension(LanguageProto))
+		if imported[importPath] {
+			continue
+		}
+		imported[importPath] = true
+
+		p.buffer.WriteString("import ")
+		p.buffer.WriteString(strconv.Quote(importPath))
+		p.buffer.WriteString(";\n")
+	}
+	if len(values.Imports) > 0 {
+		p.buffer.WriteString("\n")
+	}
+
+	// Consts have no proto3 counterpart and are left out.
+	for _, entry := range values.Entries {
+		if entry.Enum != nil {
+			p.generateEnum(entry.Enum)
+		} else if entry.Object != nil {
+			p.generateObject(entry.Object)
+		}
+	}
+
+	if len(p.errors) > 0 {
+		return nil, errors.Join(p.errors...)
+	}
+
+	return Files{outPath: bytes.Clone(p.buffer.Bytes())}, nil
+}
+
+func (p *ProtoGenerator) errorf(pos lexer.Position, format string, args ...any) {
+	p.errors = append(p.errors, &CheckError{
+		Pos:     pos,
+		Message: fmt.Sprintf(format, args...),
+	})
+}
+
+// generateEnum writes enum with its value 0 first, which proto3 uses as the default.
+func (p *ProtoGenerator) generateEnum(enum *Enum) {
+	if enum.Type.Type == "float32" || enum.Type.Type == "float64" || enum.Type.Type == "string" || enum.Type.Type == "bool" {
+		p.errorf(enum.Pos, "enum %s for %s cannot be exported, proto3 enums are integers", enum.Name, enum.Type.Type)
+		return
+	}
+
+	var zero *EnumValue
+	for i := range enum.Body {
+		v := &enum.Body[i]
+		if *v.Value.IntValue > math.MaxInt32 {
+			p.errorf(v.Value.Pos, "%s.%s value %d overflows the int32 of proto3 enums", enum.Name, v.Name, *v.Value.IntValue)
+		}
+		if *v.Value.IntValue == 0 {
+			zero = v
+		}
+	}
+	if zero == nil {
+		p.errorf(enum.Pos, "enum %s has no value 0, which proto3 needs as the default", enum.Name)
+		return
+	}
+
+	prefix := protoUpperSnake(enum.Name) + "_"
+
+	writeLineDoc(p.buffer, "", "// ", enum.Doc)
+	p.buffer.WriteString("enum ")
+	p.buffer.WriteString(enum.Name)
+	p.buffer.WriteString(" {\n")
+	p.generateEnumValue(prefix, zero)
+	for i := range enum.Body {
+		if &enum.Body[i] != zero {
+			p.generateEnumValue(prefix, &enum.Body[i])
+		}
+	}
+	p.buffer.WriteString("}\n\n")
+}
+
+// generateEnumValue writes v with the enum name as prefix, because proto3 enum values share the package scope.
+func (p *ProtoGenerator) generateEnumValue(prefix string, v *EnumValue) {
+	name := protoUpperSnake(v.Name)
+	if !strings.HasPrefix(name, prefix) {
+		name = prefix + name
+	}
+
+	writeLineDoc(p.buffer, "  ", "// ", v.Doc)
+	p.buffer.WriteString("  ")
+	p.buffer.WriteString(name)
+	p.buffer.WriteString(" = ")
+	p.buffer.WriteString(strconv.FormatInt(*v.Value.IntValue, 10))
+	p.buffer.WriteString(";\n")
+}
+
+func (p *ProtoGenerator) generateObject(object *Object) {
+	numbers := ProtoFieldNumbers(object)
+	for i := range object.Fields {
+		// Checked schemas have numbers, but Generate may be given an unchecked one.
+		if attr := FieldAttribute(&object.Fields[i], AttributeProto); attr != nil && (attr.Value == nil || attr.Value.IntValue == nil) {
+			p.errorf(attr.Pos, "@%s on %s.%s needs a field number, e.g. @%s(1)", attr.Name, object.Name, object.Fields[i].Name, attr.Name)
+		}
+	}
+
+	writeLineDoc(p.buffer, "", "// ", object.Doc)
+	p.buffer.WriteString("message ")
+	p.buffer.WriteString(object.Name)
+	p.buffer.WriteString(" {\n")
+	for i, f := range object.Fields {
+		writeLineDoc(p.buffer, "  ", "// ", f.Doc)
+		p.buffer.WriteString("  ")
+		if f.Type.ListType != nil {
+			p.buffer.WriteString("repeated ")
+			p.generateElementType(&f.Type.ListType.ElementType, object.Name+"."+f.Name)
+		} else if f.Type.MapType != nil {
+			p.generateMapType(f.Type.MapType, object.Name+"."+f.Name)
+		} else {
+			if IsOptionalField(&f) && !p.isMessage(&f.Type) {
+				p.buffer.WriteString("optional ")
+			}
+			p.generateType(&f.Type)
+		}
+		p.buffer.WriteString(" ")
+		p.buffer.WriteString(f.Name)
+		p.buffer.WriteString(" = ")
+		p.buffer.WriteString(strconv.FormatInt(numbers[i], 10))
+		if name := JSONName(&f); SnakeToCamel(f.Name) != name {
+			// Keep the gidle JSON name instead of the lowerCamelCase default.
+			p.buffer.WriteString(" [json_name = ")
+			p.buffer.WriteString(strconv.Quote(name))
+			p.buffer.WriteString("]")
+		}
+		p.buffer.WriteString(";\n")
+	}
+	p.buffer.WriteString("}\n\n")
+}
+
+// generateElementType writes the element type of a repeated field or map value, which cannot be repeated or a map itself.
+func (p *ProtoGenerator) generateElementType(t *Type, name string) {
+	if t.ListType != nil || t.MapType != nil {
+		p.errorf(t.Pos, "%s nests a list or map in a list or map, which proto3 cannot express; wrap it in an object", name)
+		return
+	}
+
+	p.generateType(t)
+}
+
+func (p *ProtoGenerator) generateMapType(t *MapType, name string) {
+	if t.KeyType.Type == "float32" || t.KeyType.Type == "float64" {
+		p.errorf(t.ValueType.Pos, "%s has %s keys, which proto3 maps do not accept", name, t.KeyType.Type)
+		return
+	}
+
+	p.buffer.WriteString("map<")
+	p.generatePrimitiveType(&t.KeyType)
+	p.buffer.WriteString(", ")
+	p.generateElementType(&t.ValueType, name)
+	p.buffer.WriteString(">")
+}
+
+func (p *ProtoGenerator) generateType(t *Type) {
+	if t.PrimitiveType != nil {
+		p.generatePrimitiveType(t.PrimitiveType)
+	} else if t.Identity != nil {
+		qualifier, name := SplitIdentity(*t.Identity)
+		if qualifier != "" {
+			if imp := LookupImport(p.values, qualifier); imp != nil && imp.Grammar != nil && !IsSamePackage(p.values, imp.Grammar) {
+				p.buffer.WriteString(strings.Join(imp.Grammar.Package.Names, "."))
+				p.buffer.WriteString(".")
+			}
+		}
+		p.buffer.WriteString(name)
+	}
+}
+
+// isMessage reports whether t refers to an object, whose presence proto3 already tracks.
+func (p *ProtoGenerator) isMessage(t *Type) bool {
+	if t.Identity == nil {
+		return false
+	}
+
+	_, entry := LookupEntry(p.values, *t.Identity)
+	return entry != nil && entry.Object != nil
+}
+
+func (p *ProtoGenerator) generatePrimitiveType(t *PrimitiveType) {
+	switch t.Type {
+	case "int8", "int16", "int32":
+		p.buffer.WriteString("int32")
+	case "int64":
+		p.buffer.WriteString("int64")
+	case "uint8", "uint16", "uint32":
+		p.buffer.WriteString("uint32")
+	case "uint64":
+		p.buffer.WriteString("uint64")
+	case "float32":
+		p.buffer.WriteString("float")
+	case "float64":
+		p.buffer.WriteString("double")
+	case "bool":
+		p.buffer.WriteString("bool")
+	default:
+		p.buffer.WriteString("string")
+	}
+}
+
+// ProtoFieldNumbers returns the field number of every field of object. Fields without
+// `@proto(n)` take the lowest numbers left free by the annotated ones, in declaration order,
+// so their numbers change when fields are inserted or reordered. The Checker only accepts
+// objects that number all of their fields with `@proto` or none.
+func ProtoFieldNumbers(object *Object) []int64 {
+	used := make(map[int64]bool)
+	for i := range object.Fields {
+		if attr := FieldAttribute(&object.Fields[i], AttributeProto); attr != nil && attr.Value != nil && attr.Value.IntValue != nil {
+			used[*attr.Value.IntValue] = true
+		}
+	}
+
+	numbers := make([]int64, len(object.Fields))
+	next := int64(1)
+	for i := range object.Fields {
+		if attr := FieldAttribute(&object.Fields[i], AttributeProto); attr != nil && attr.Value != nil && attr.Value.IntValue != nil {
+			numbers[i] = *attr.Value.IntValue
+			continue
+		}
+
+		for used[next] || next >= ProtoReservedFieldNumberMin && next <= ProtoReservedFieldNumberMax {
+			next++
+		}
+		numbers[i] = next
+		used[next] = true
+	}
+
+	return numbers
+}
+
+// protoUpperSnake converts a PascalCase, camelCase or snake_case name to UPPER_SNAKE_CASE.
+func protoUpperSnake(s string) string {
+	sb := strings.Builder{}
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if c >= 'A' && c <= 'Z' && i > 0 && (s[i-1] >= 'a' && s[i-1] <= 'z' || s[i-1] >= '0' && s[i-1] <= '9') {
+			sb.WriteByte('_')
+		}
+		if c >= 'a' && c <= 'z' {
+			c -= 32
+		}
+		sb.WriteByte(c)
+	}
+
+	return sb.String()
+}
@@ -0,0 +1,377 @@
+package gidle
+
+import (
+	"bytes"
+	"path"
+	"strconv"
+	"strings"
+)
+
+var pythonKeywords = map[string]bool{
+	"False": true, "None": true, "True": true, "and": true, "as": true, "assert": true, "async": true,
+	"await": true, "break": true, "class": true, "continue": true, "def": true, "del": true, "elif": true,
+	"else": true, "except": true, "finally": true, "for": true, "from": true, "global": true, "if": true,
+	"import": true, "in": true, "is": true, "lambda": true, "nonlocal": true, "not": true, "or": true,
+	"pass": true, "raise": true, "return": true, "try": true, "while": true, "with": true, "yield": true,
+}
+
+type PythonGenerator struct {
+	buffer *bytes.Buffer
+	values *Grammar
+}
+
+func NewPythonGenerator() *PythonGenerator {
+	return &PythonGenerator{
+		buffer: bytes.NewBuffer(nil),
+	}
+}
+
+func (p *PythonGenerator) Generate(outPath string, values *Grammar) (Files, error) {
+	p.buffer.Reset()
+	p.values = values
+
+	p.buffer.WriteString("# Package ")
+	p.buffer.WriteString(strings.Join(values.Package.Names, "."))
+	p.buffer.WriteString("\n")
+	p.buffer.WriteString("from __future__ import annotations\n\n")
+	p.buffer.WriteString("import json\n")
+	p.buffer.WriteString("from dataclasses import dataclass\n")
+	p.buffer.WriteString("from enum import Enum, IntEnum\n")
+	p.buffer.WriteString("from typing import Any, Dict, List, Optional\n")
+	for i := range values.Imports {
+		imp := &values.Imports[i]
+		if imp.Grammar == nil {
+			continue
+		}
+
+		module := path.Base(ImportOutputPath(imp, ""))
+		p.buffer.WriteString("from ")
+		p.buffer.WriteString(strings.Join(imp.Grammar.Package.Names, "."))
+		p.buffer.WriteString(" import ")
+		p.buffer.WriteString(module)
+		if qualifier := ImportQualifier(imp); qualifier != module {
+			p.buffer.WriteString(" as ")
+			p.buffer.WriteString(qualifier)
+		}
+		p.buffer.WriteString("\n")
+	}
+	p.buffer.WriteString("\n\n")
+
+	for _, entry := range values.Entries {
+		if entry.Const != nil {
+			p.generateConst(entry.Const)
+		} else if entry.Enum != nil {
+			p.generateEnum(entry.Enum)
+		} else if entry.Object != nil {
+			p.generateObject(entry.Object)
+		}
+	}
+
+	return Files{outPath: bytes.Clone(p.buffer.Bytes())}, nil
+}
+
+func (p *PythonGenerator) generatePrimitiveValue(value *PrimitiveValue) {
+	if value.StringValue != nil {
+		p.buffer.WriteString(strconv.Quote(*value.StringValue))
+	} else if value.IntValue != nil {
+		p.buffer.WriteString(strconv.FormatInt(*value.IntValue, 10))
+	} else if value.FloatValue != nil {
+		p.buffer.WriteString(strconv.FormatFloat(*value.FloatValue, 'f', -1, 64))
+	} else if value.BoolValue != nil {
+		if *value.BoolValue {
+			p.buffer.WriteString("True")
+		} else {
+			p.buffer.WriteString("False")
+		}
+	} else {
+		p.buffer.WriteString("None")
+	}
+}
+
+// generateDocstring writes doc as the docstring of a class body indented by indent.
+func (p *PythonGenerator) generateDocstring(indent string, doc Doc) {
+	if len(doc) == 0 {
+		return
+	}
+
+	escaper := strings.NewReplacer("\\", "\\\\", `"`, `\"`)
+	p.buffer.WriteString(indent)
+	p.buffer.WriteString(`"""`)
+	p.buffer.WriteString(escaper.Replace(doc[0]))
+	if len(doc) > 1 {
+		p.buffer.WriteString("\n")
+		for _, line := range doc[1:] {
+			if line != "" {
+				p.buffer.WriteString(indent)
+				p.buffer.WriteString(escaper.Replace(line))
+			}
+			p.buffer.WriteString("\n")
+		}
+		p.buffer.WriteString(indent)
+	}
+	p.buffer.WriteString(`"""`)
+	p.buffer.WriteString("\n")
+}
+
+func (p *PythonGenerator) generateConst(constant *Const) {
+	for _, f := range constant.Fields {
+		writeLineDoc(p.buffer, "", "#: ", joinDoc(constant.Doc, f.Doc))
+		p.buffer.WriteString(pythonName(constant.Name + "_" + f.Name))
+		p.buffer.WriteString(": ")
+		p.generatePrimitiveType(&constant.Type)
+		p.buffer.WriteString(" = ")
+		p.generatePrimitiveValue(&f.Value)
+		p.buffer.WriteString("\n")
+	}
+	p.buffer.WriteString("\n\n")
+}
+
+func (p *PythonGenerator) generateEnum(enum *Enum) {
+	p.buffer.WriteString("class ")
+	p.buffer.WriteString(enum.Name)
+	switch enum.Type.Type {
+	case "string":
+		// StrEnum needs Python 3.11.
+		p.buffer.WriteString("(str, Enum):\n")
+	case "float32", "float64", "bool":
+		p.buffer.WriteString("(Enum):\n")
+	default:
+		p.buffer.WriteString("(IntEnum):\n")
+	}
+	p.generateDocstring("    ", enum.Doc)
+
+	if len(enum.Body) == 0 {
+		p.buffer.WriteString("    pass\n")
+	}
+	for _, v := range enum.Body {
+		writeLineDoc(p.buffer, "    ", "#: ", v.Doc)
+		p.buffer.WriteString("    ")
+		p.buffer.WriteString(pythonName(v.Name))
+		p.buffer.WriteString(" = ")
+		p.generatePrimitiveValue(&v.Value)
+		p.buffer.WriteString("\n")
+	}
+	p.buffer.WriteString("\n\n")
+}
+
+func (p *PythonGenerator) generateType(t *Type) {
+	if t.PrimitiveType != nil {
+		p.generatePrimitiveType(t.PrimitiveType)
+	} else if t.ListType != nil {
+		p.buffer.WriteString("List[")
+		p.generateType(&t.ListType.ElementType)
+		p.buffer.WriteString("]")
+	} else if t.MapType != nil {
+		p.buffer.WriteString("Dict[")
+		p.generatePrimitiveType(&t.MapType.KeyType)
+		p.buffer.WriteString(", ")
+		p.generateType(&t.MapType.ValueType)
+		p.buffer.WriteString("]")
+	} else if t.Identity != nil {
+		p.buffer.WriteString(*t.Identity)
+	}
+}
+
+func (p *PythonGenerator) generatePrimitiveType(t *PrimitiveType) {
+	switch t.Type {
+	case "string":
+		p.buffer.WriteString("str")
+	case "float32", "float64":
+		p.buffer.WriteString("float")
+	case "bool":
+		p.buffer.WriteString("bool")
+	default:
+		p.buffer.WriteString("int")
+	}
+}
+
+func (p *PythonGenerator) generateObject(object *Object) {
+	p.buffer.WriteString("@dataclass(kw_only=True)\n")
+	p.buffer.WriteString("class ")
+	p.buffer.WriteString(object.Name)
+	p.buffer.WriteString(":\n")
+	p.generateDocstring("    ", object.Doc)
+
+	for _, f := range object.Fields {
+		writeLineDoc(p.buffer, "    ", "#: ", f.Doc)
+		p.buffer.WriteString("    ")
+		p.buffer.WriteString(pythonName(f.Name))
+		p.buffer.WriteString(": ")
+		if IsOptionalField(&f) {
+			p.buffer.WriteString("Optional[")
+			p.generateType(&f.Type)
+			p.buffer.WriteString("] = None\n")
+		} else {
+			p.generateType(&f.Type)
+			p.buffer.WriteString("\n")
+		}
+	}
+	if len(object.Fields) > 0 {
+		p.buffer.WriteString("\n")
+	}
+
+	p.buffer.WriteString("    @classmethod\n")
+	p.buffer.WriteString("    def from_dict(cls, data: Dict[str, Any]) -> ")
+	p.buffer.WriteString(object.Name)
+	p.buffer.WriteString(":\n")
+	p.buffer.WriteString("        return cls(\n")
+	for _, f := range object.Fields {
+		p.buffer.WriteString("            ")
+		p.buffer.WriteString(pythonName(f.Name))
+		p.buffer.WriteString("=")
+		if IsOptionalField(&f) {
+			value := "data.get(" + strconv.Quote(JSONName(&f)) + ")"
+			p.generateFromDictExpression(&f.Type, value, 0)
+			p.buffer.WriteString(" if ")
+			p.buffer.WriteString(value)
+			p.buffer.WriteString(" is not None else None")
+		} else {
+			p.generateFromDictExpression(&f.Type, "data["+strconv.Quote(JSONName(&f))+"]", 0)
+		}
+		p.buffer.WriteString(",\n")
+	}
+	p.buffer.WriteString("        )\n\n")
+
+	p.buffer.WriteString("    def to_dict(self) -> Dict[str, Any]:\n")
+	p.buffer.WriteString("        return {\n")
+	for _, f := range object.Fields {
+		value := "self." + pythonName(f.Name)
+
+		p.buffer.WriteString("            ")
+		p.buffer.WriteString(strconv.Quote(JSONName(&f)))
+		p.buffer.WriteString(": ")
+		p.generateToDictExpression(&f.Type, value, 0)
+		if IsOptionalField(&f) && p.needsEncoding(&f.Type) {
+			p.buffer.WriteString(" if ")
+			p.buffer.WriteString(value)
+			p.buffer.WriteString(" is not None else None")
+		}
+		p.buffer.WriteString(",\n")
+	}
+	p.buffer.WriteString("        }\n\n")
+
+	p.buffer.WriteString("    @classmethod\n")
+	p.buffer.WriteString("    def from_json(cls, source: str) -> ")
+	p.buffer.WriteString(object.Name)
+	p.buffer.WriteString(":\n")
+	p.buffer.WriteString("        return cls.from_dict(json.loads(source))\n\n")
+
+	p.buffer.WriteString("    def to_json(self) -> str:\n")
+	p.buffer.WriteString("        return json.dumps(self.to_dict())\n\n\n")
+}
+
+// generateFromDictExpression converts expr, a value decoded by json.loads, to t.
+// depth names the variables of nested comprehensions.
+func (p *PythonGenerator) generateFromDictExpression(t *Type, expr string, depth int) {
+	e := "e" + strconv.Itoa(depth)
+	if t.PrimitiveType != nil {
+		switch t.PrimitiveType.Type {
+		case "float32", "float64":
+			p.buffer.WriteString("float(")
+			p.buffer.WriteString(expr)
+			p.buffer.WriteString(")")
+		default:
+			p.buffer.WriteString(expr)
+		}
+	} else if t.ListType != nil {
+		p.buffer.WriteString("[")
+		p.generateFromDictExpression(&t.ListType.ElementType, e, depth+1)
+		p.buffer.WriteString(" for ")
+		p.buffer.WriteString(e)
+		p.buffer.WriteString(" in ")
+		p.buffer.WriteString(expr)
+		p.buffer.WriteString("]")
+	} else if t.MapType != nil {
+		k := "k" + strconv.Itoa(depth)
+		p.buffer.WriteString("{")
+		switch t.MapType.KeyType.Type {
+		case "string":
+			p.buffer.WriteString(k)
+		case "float32", "float64":
+			p.buffer.WriteString("float(" + k + ")")
+		case "bool":
+			p.buffer.WriteString(k + " == \"true\"")
+		default:
+			p.buffer.WriteString("int(" + k + ")")
+		}
+		p.buffer.WriteString(": ")
+		p.generateFromDictExpression(&t.MapType.ValueType, e, depth+1)
+		p.buffer.WriteString(" for ")
+		p.buffer.WriteString(k)
+		p.buffer.WriteString(", ")
+		p.buffer.WriteString(e)
+		p.buffer.WriteString(" in ")
+		p.buffer.WriteString(expr)
+		p.buffer.WriteString(".items()}")
+	} else if t.Identity != nil {
+		p.buffer.WriteString(*t.Identity)
+		if _, entry := LookupEntry(p.values, *t.Identity); entry != nil && entry.Enum != nil {
+			p.buffer.WriteString("(")
+		} else {
+			p.buffer.WriteString(".from_dict(")
+		}
+		p.buffer.WriteString(expr)
+		p.buffer.WriteString(")")
+	}
+}
+
+// generateToDictExpression converts expr of type t to a value json.dumps accepts.
+func (p *PythonGenerator) generateToDictExpression(t *Type, expr string, depth int) {
+	e := "e" + strconv.Itoa(depth)
+	if !p.needsEncoding(t) {
+		p.buffer.WriteString(expr)
+	} else if t.ListType != nil {
+		p.buffer.WriteString("[")
+		p.generateToDictExpression(&t.ListType.ElementType, e, depth+1)
+		p.buffer.WriteString(" for ")
+		p.buffer.WriteString(e)
+		p.buffer.WriteString(" in ")
+		p.buffer.WriteString(expr)
+		p.buffer.WriteString("]")
+	} else if t.MapType != nil {
+		k := "k" + strconv.Itoa(depth)
+		p.buffer.WriteString("{")
+		if t.MapType.KeyType.Type == "bool" {
+			p.buffer.WriteString("\"true\" if " + k + " else \"false\"")
+		} else {
+			p.buffer.WriteString("str(" + k + ")")
+		}
+		p.buffer.WriteString(": ")
+		p.generateToDictExpression(&t.MapType.ValueType, e, depth+1)
+		p.buffer.WriteString(" for ")
+		p.buffer.WriteString(k)
+		p.buffer.WriteString(", ")
+		p.buffer.WriteString(e)
+		p.buffer.WriteString(" in ")
+		p.buffer.WriteString(expr)
+		p.buffer.WriteString(".items()}")
+	} else if t.Identity != nil {
+		p.buffer.WriteString(expr)
+		if _, entry := LookupEntry(p.values, *t.Identity); entry != nil && entry.Enum != nil {
+			p.buffer.WriteString(".value")
+		} else {
+			p.buffer.WriteString(".to_dict()")
+		}
+	}
+}
+
+// needsEncoding reports whether values of t must be converted before json.dumps.
+func (p *PythonGenerator) needsEncoding(t *Type) bool {
+	if t.PrimitiveType != nil {
+		return false
+	} else if t.ListType != nil {
+		return p.needsEncoding(&t.ListType.ElementType)
+	} else if t.MapType != nil {
+		return t.MapType.KeyType.Type != "string" || p.needsEncoding(&t.MapType.ValueType)
+	}
+
+	return true
+}
+
+func pythonName(name string) string {
+	if pythonKeywords[name] {
+		return name + "_"
+	}
+
+	return name
+}
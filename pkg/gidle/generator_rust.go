@@ -1,9 +1,9 @@
-package main
+package gidle
 
 import (
 	"bytes"
-	"os"
 	"strconv"
+	"strings"
 )
 
 type RustGenerator struct {
@@ -16,12 +16,27 @@ func NewRustGenerator() *RustGenerator {
 	}
 }
 
-func (r *RustGenerator) Generate(outPath string, values *Grammar) error {
+func (r *RustGenerator) Generate(outPath string, values *Grammar) (Files, error) {
 	r.buffer.Reset()
 
 	r.buffer.WriteString("use std::collections::HashMap;\n")
 	r.buffer.WriteString("use serde::{Deserialize, Serialize};\n")
 	r.buffer.WriteString("use serde_json::{to_string, from_str, Result};\n")
+	for i := range values.Imports {
+		imp := &values.Imports[i]
+		if imp.Grammar == nil {
+			continue
+		}
+
+		names := imp.Grammar.Package.Names
+		r.buffer.WriteString("use crate::")
+		r.buffer.WriteString(strings.Join(names, "::"))
+		if qualifier := ImportQualifier(imp); qualifier != names[len(names)-1] {
+			r.buffer.WriteString(" as ")
+			r.buffer.WriteString(qualifier)
+		}
+		r.buffer.WriteString(";\n")
+	}
 	r.buffer.WriteString("\n")
 
 	for _, entry := range values.Entries {
@@ -34,11 +49,7 @@ func (r *RustGenerator) Generate(outPath string, values *Grammar) error {
 		}
 	}
 
-	if err := os.WriteFile(outPath, r.buffer.Bytes(), 0644); err != nil {
-		return err
-	}
-
-	return nil
+	return Files{outPath: bytes.Clone(r.buffer.Bytes())}, nil
 }
 
 func (r *RustGenerator) generatePrimitiveValue(value *PrimitiveValue) {
@@ -51,14 +62,17 @@ func (r *RustGenerator) generatePrimitiveValue(value *PrimitiveValue) {
 	} else if value.FloatValue != nil {
 		r.buffer.WriteString(strconv.FormatFloat(*value.FloatValue, 'f', -1, 64))
 	} else if value.BoolValue != nil {
-		r.buffer.WriteString(strconv.FormatBool(*value.BoolValue))
+		r.buffer.WriteString(strconv.FormatBool(bool(*value.BoolValue)))
 	} else {
 		r.buffer.WriteString("null")
 	}
 }
 
 func (r *RustGenerator) generateConst(constant *Const) {
+	// Rust has no const groups, so the group's doc is a plain comment above its consts.
+	writeLineDoc(r.buffer, "", "// ", constant.Doc)
 	for _, f := range constant.Fields {
+		writeLineDoc(r.buffer, "", "/// ", f.Doc)
 		r.buffer.WriteString("pub const ")
 		r.buffer.WriteString(constant.Name)
 		r.buffer.WriteString("_")
@@ -72,10 +86,12 @@ func (r *RustGenerator) generateConst(constant *Const) {
 }
 
 func (r *RustGenerator) generateEnum(enum *Enum) {
+	writeLineDoc(r.buffer, "", "/// ", enum.Doc)
 	r.buffer.WriteString("pub enum ")
 	r.buffer.WriteString(enum.Name)
 	r.buffer.WriteString(" {\n")
 	for _, v := range enum.Body {
+		writeLineDoc(r.buffer, "\t", "/// ", v.Doc)
 		r.buffer.WriteString("\t")
 		r.buffer.WriteString(v.Name)
 		r.buffer.WriteString(" = ")
@@ -96,15 +112,26 @@ func (r *RustGenerator) generateType(t *Type) {
 		r.buffer.WriteString("HashMap<")
 		r.generatePrimitiveType(&t.MapType.KeyType)
 		r.buffer.WriteString(", ")
-		r.generatePrimitiveType(&t.MapType.ValueType)
+		r.generateType(&t.MapType.ValueType)
 		r.buffer.WriteString(">")
 	} else if t.Identity != nil {
-		r.buffer.WriteString(*t.Identity)
+		r.buffer.WriteString(strings.Replace(*t.Identity, ".", "::", 1))
 	} else {
 		panic("unreachable")
 	}
 }
 
+func (r *RustGenerator) generateFieldType(f *ObjectField) {
+	if IsOptionalField(f) {
+		r.buffer.WriteString("Option<")
+		r.generateType(&f.Type)
+		r.buffer.WriteString(">")
+		return
+	}
+
+	r.generateType(&f.Type)
+}
+
 func (r *RustGenerator) generatePrimitiveType(t *PrimitiveType) {
 	switch t.Type {
 	case "int8":
@@ -137,15 +164,25 @@ func (r *RustGenerator) generatePrimitiveType(t *PrimitiveType) {
 }
 
 func (r *RustGenerator) generateObject(object *Object) {
+	writeLineDoc(r.buffer, "", "/// ", object.Doc)
 	r.buffer.WriteString("#[derive(Debug, Serialize, Deserialize)]\n")
 	r.buffer.WriteString("pub struct ")
 	r.buffer.WriteString(object.Name)
 	r.buffer.WriteString(" {\n")
 	for _, f := range object.Fields {
+		writeLineDoc(r.buffer, "\t", "/// ", f.Doc)
+		if IsOptionalField(&f) {
+			r.buffer.WriteString("\t#[serde(default, skip_serializing_if = \"Option::is_none\")]\n")
+		}
+		if name := JSONName(&f); name != f.Name {
+			r.buffer.WriteString("\t#[serde(rename = ")
+			r.buffer.WriteString(strconv.Quote(name))
+			r.buffer.WriteString(")]\n")
+		}
 		r.buffer.WriteString("\tpub ")
 		r.buffer.WriteString(f.Name)
 		r.buffer.WriteString(": ")
-		r.generateType(&f.Type)
+		r.generateFieldType(&f)
 		r.buffer.WriteString(",\n")
 	}
 	r.buffer.WriteString("}\n\n")
@@ -161,7 +198,7 @@ func (r *RustGenerator) generateObject(object *Object) {
 		}
 		r.buffer.WriteString(f.Name)
 		r.buffer.WriteString(": ")
-		r.generateType(&f.Type)
+		r.generateFieldType(&f)
 	}
 	r.buffer.WriteString(") -> Self {\n")
 	r.buffer.WriteString("\t\tSelf {\n")
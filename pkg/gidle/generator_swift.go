@@ -0,0 +1,266 @@
+package gidle
+
+import (
+	"bytes"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+var swiftKeywords = map[string]bool{
+	"as": true, "break": true, "case": true, "catch": true, "class": true, "continue": true, "default": true,
+	"defer": true, "do": true, "else": true, "enum": true, "extension": true, "false": true, "for": true,
+	"func": true, "guard": true, "if": true, "import": true, "in": true, "init": true, "internal": true,
+	"is": true, "let": true, "nil": true, "operator": true, "private": true, "protocol": true,
+	"public": true, "repeat": true, "return": true, "self": true, "static": true, "struct": true,
+	"subscript": true, "super": true, "switch": true, "throw": true, "throws": true, "true": true,
+	"try": true, "var": true, "where": true, "while": true,
+}
+
+type SwiftGenerator struct {
+	buffer *bytes.Buffer
+}
+
+func NewSwiftGenerator() *SwiftGenerator {
+	return &SwiftGenerator{
+		buffer: bytes.NewBuffer(nil),
+	}
+}
+
+func (s *SwiftGenerator) Generate(outPath string, values *Grammar) (Files, error) {
+	s.buffer.Reset()
+
+	s.buffer.WriteString("import Foundation\n\n")
+
+	for _, entry := range values.Entries {
+		if entry.Const != nil {
+			s.generateConst(entry.Const)
+		} else if entry.Enum != nil {
+			s.generateEnum(entry.Enum)
+		} else if entry.Object != nil {
+			s.generateObject(entry.Object)
+		}
+	}
+
+	return Files{outPath: bytes.Clone(s.buffer.Bytes())}, nil
+}
+
+func (s *SwiftGenerator) generatePrimitiveValue(value *PrimitiveValue) {
+	if value.StringValue != nil {
+		s.buffer.WriteString(swiftQuote(*value.StringValue))
+	} else if value.IntValue != nil {
+		s.buffer.WriteString(strconv.FormatInt(*value.IntValue, 10))
+	} else if value.FloatValue != nil {
+		s.buffer.WriteString(strconv.FormatFloat(*value.FloatValue, 'f', -1, 64))
+	} else if value.BoolValue != nil {
+		s.buffer.WriteString(strconv.FormatBool(bool(*value.BoolValue)))
+	} else {
+		s.buffer.WriteString("nil")
+	}
+}
+
+// generateConst writes constant as a caseless enum, which cannot be instantiated.
+func (s *SwiftGenerator) generateConst(constant *Const) {
+	writeLineDoc(s.buffer, "", "/// ", constant.Doc)
+	s.buffer.WriteString("enum ")
+	s.buffer.WriteString(constant.Name)
+	s.buffer.WriteString(" {\n")
+	for _, f := range constant.Fields {
+		writeLineDoc(s.buffer, "    ", "/// ", f.Doc)
+		s.buffer.WriteString("    static let ")
+		s.buffer.WriteString(swiftName(f.Name))
+		s.buffer.WriteString(": ")
+		s.generatePrimitiveType(&constant.Type)
+		s.buffer.WriteString(" = ")
+		s.generatePrimitiveValue(&f.Value)
+		s.buffer.WriteString("\n")
+	}
+	s.buffer.WriteString("}\n\n")
+}
+
+func (s *SwiftGenerator) generateEnum(enum *Enum) {
+	if enum.Type.Type == "bool" {
+		s.generateBoolEnum(enum)
+		return
+	}
+
+	writeLineDoc(s.buffer, "", "/// ", enum.Doc)
+	s.buffer.WriteString("enum ")
+	s.buffer.WriteString(enum.Name)
+	s.buffer.WriteString(": ")
+	s.generatePrimitiveType(&enum.Type)
+	s.buffer.WriteString(", Codable {\n")
+	for _, v := range enum.Body {
+		writeLineDoc(s.buffer, "    ", "/// ", v.Doc)
+		s.buffer.WriteString("    case ")
+		s.buffer.WriteString(swiftName(v.Name))
+		s.buffer.WriteString(" = ")
+		s.generatePrimitiveValue(&v.Value)
+		s.buffer.WriteString("\n")
+	}
+	s.buffer.WriteString("}\n\n")
+}
+
+// generateBoolEnum writes enum conforming to RawRepresentable by hand,
+// because Swift does not accept Bool as an enum raw type.
+func (s *SwiftGenerator) generateBoolEnum(enum *Enum) {
+	writeLineDoc(s.buffer, "", "/// ", enum.Doc)
+	s.buffer.WriteString("enum ")
+	s.buffer.WriteString(enum.Name)
+	s.buffer.WriteString(": RawRepresentable, Codable {\n")
+	for _, v := range enum.Body {
+		writeLineDoc(s.buffer, "    ", "/// ", v.Doc)
+		s.buffer.WriteString("    case ")
+		s.buffer.WriteString(swiftName(v.Name))
+		s.buffer.WriteString("\n")
+	}
+	s.buffer.WriteString("\n")
+
+	s.buffer.WriteString("    init?(rawValue: Bool) {\n")
+	s.buffer.WriteString("        switch rawValue {\n")
+	for _, v := range enum.Body {
+		s.buffer.WriteString("        case ")
+		s.generatePrimitiveValue(&v.Value)
+		s.buffer.WriteString(": self = .")
+		s.buffer.WriteString(swiftName(v.Name))
+		s.buffer.WriteString("\n")
+	}
+	if len(enum.Body) < 2 {
+		s.buffer.WriteString("        default: return nil\n")
+	}
+	s.buffer.WriteString("        }\n")
+	s.buffer.WriteString("    }\n\n")
+
+	s.buffer.WriteString("    var rawValue: Bool {\n")
+	s.buffer.WriteString("        switch self {\n")
+	for _, v := range enum.Body {
+		s.buffer.WriteString("        case .")
+		s.buffer.WriteString(swiftName(v.Name))
+		s.buffer.WriteString(": return ")
+		s.generatePrimitiveValue(&v.Value)
+		s.buffer.WriteString("\n")
+	}
+	s.buffer.WriteString("        }\n")
+	s.buffer.WriteString("    }\n")
+	s.buffer.WriteString("}\n\n")
+}
+
+func (s *SwiftGenerator) generateType(t *Type) {
+	if t.PrimitiveType != nil {
+		s.generatePrimitiveType(t.PrimitiveType)
+	} else if t.ListType != nil {
+		s.buffer.WriteString("[")
+		s.generateType(&t.ListType.ElementType)
+		s.buffer.WriteString("]")
+	} else if t.MapType != nil {
+		// JSONEncoder only writes dictionaries keyed by String or Int as JSON objects,
+		// so keys of every type are read as the String of the JSON object.
+		s.buffer.WriteString("[String: ")
+		s.generateType(&t.MapType.ValueType)
+		s.buffer.WriteString("]")
+	} else if t.Identity != nil {
+		// Swift has no package qualifiers; imported schemas must be compiled into the same module.
+		_, name := SplitIdentity(*t.Identity)
+		s.buffer.WriteString(name)
+	}
+}
+
+func (s *SwiftGenerator) generatePrimitiveType(t *PrimitiveType) {
+	switch t.Type {
+	case "int8":
+		s.buffer.WriteString("Int8")
+	case "int16":
+		s.buffer.WriteString("Int16")
+	case "int32":
+		s.buffer.WriteString("Int32")
+	case "int64":
+		s.buffer.WriteString("Int64")
+	case "uint8":
+		s.buffer.WriteString("UInt8")
+	case "uint16":
+		s.buffer.WriteString("UInt16")
+	case "uint32":
+		s.buffer.WriteString("UInt32")
+	case "uint64":
+		s.buffer.WriteString("UInt64")
+	case "float32":
+		s.buffer.WriteString("Float")
+	case "float64":
+		s.buffer.WriteString("Double")
+	case "bool":
+		s.buffer.WriteString("Bool")
+	default:
+		s.buffer.WriteString("String")
+	}
+}
+
+func (s *SwiftGenerator) generateObject(object *Object) {
+	writeLineDoc(s.buffer, "", "/// ", object.Doc)
+	s.buffer.WriteString("struct ")
+	s.buffer.WriteString(object.Name)
+	s.buffer.WriteString(": Codable, Equatable {\n")
+	for _, f := range object.Fields {
+		writeLineDoc(s.buffer, "    ", "/// ", f.Doc)
+		s.buffer.WriteString("    var ")
+		s.buffer.WriteString(swiftName(SnakeToCamel(f.Name)))
+		s.buffer.WriteString(": ")
+		s.generateType(&f.Type)
+		if IsOptionalField(&f) {
+			s.buffer.WriteString("?")
+		}
+		s.buffer.WriteString("\n")
+	}
+
+	if len(object.Fields) > 0 {
+		s.buffer.WriteString("\n")
+		s.buffer.WriteString("    enum CodingKeys: String, CodingKey {\n")
+		for _, f := range object.Fields {
+			s.buffer.WriteString("        case ")
+			s.buffer.WriteString(swiftName(SnakeToCamel(f.Name)))
+			if name := JSONName(&f); SnakeToCamel(f.Name) != name {
+				s.buffer.WriteString(" = ")
+				s.buffer.WriteString(swiftQuote(name))
+			}
+			s.buffer.WriteString("\n")
+		}
+		s.buffer.WriteString("    }\n")
+	}
+	s.buffer.WriteString("}\n\n")
+}
+
+func swiftName(name string) string {
+	if swiftKeywords[name] {
+		return "`" + name + "`"
+	}
+
+	return name
+}
+
+// swiftQuote returns s as a Swift string literal. Go escapes such as \x are not valid in Swift.
+func swiftQuote(s string) string {
+	sb := strings.Builder{}
+	sb.WriteByte('"')
+	for _, r := range s {
+		switch r {
+		case '"':
+			sb.WriteString("\\\"")
+		case '\\':
+			sb.WriteString("\\\\")
+		case '\n':
+			sb.WriteString("\\n")
+		case '\r':
+			sb.WriteString("\\r")
+		case '\t':
+			sb.WriteString("\\t")
+		default:
+			if unicode.IsPrint(r) {
+				sb.WriteRune(r)
+			} else {
+				sb.WriteString("\\u{" + strconv.FormatInt(int64(r), 16) + "}")
+			}
+		}
+	}
+	sb.WriteByte('"')
+
+	return sb.String()
+}
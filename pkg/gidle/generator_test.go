@@ -0,0 +1,156 @@
+package gidle
+
+import (
+	"bytes"
+	"flag"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+var update = flag.Bool("update", false, "rewrite the golden files in testdata")
+
+// goldenVariants are generators with non-default options, tested in addition
+// to every registered language with its defaults.
+var goldenVariants = []struct {
+	name    string
+	lang    string
+	options GeneratorOptions
+}{
+	{"go-options", LanguageGo, GeneratorOptions{"package": "model", "module": "example.com/gen"}},
+	{"ts-esm-record", LanguageTypeScript, GeneratorOptions{"module": TypeScriptModuleESM, "map": TypeScriptMapRecord}},
+	{"cs-namespace", LanguageCSharp, GeneratorOptions{"namespace": "Acme.Models"}},
+	{"java-pojo", LanguageJava, GeneratorOptions{"class": JavaClassPOJO}},
+}
+
+// TestGenerators generates every testdata/*.gidle fixture with every generator and compares the
+// files with testdata/golden/<generator>. A generator error is compared with <fixture>.error.
+// Run `go test ./pkg/gidle -update` to rewrite the golden files after an intended change.
+func TestGenerators(t *testing.T) {
+	fixtures, err := filepath.Glob(filepath.Join("testdata", "*.gidle"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, fixture := range fixtures {
+		schema, err := ParseFile(fixture)
+		if err != nil {
+			t.Fatalf("%s: %v", fixture, err)
+		}
+		name := strings.TrimSuffix(filepath.Base(fixture), filepath.Ext(fixture))
+
+		for _, lang := range Languages() {
+			t.Run(lang+"/"+name, func(t *testing.T) {
+				testGolden(t, lang, lang, nil, name, schema)
+			})
+		}
+		for _, v := range goldenVariants {
+			t.Run(v.name+"/"+name, func(t *testing.T) {
+				testGolden(t, v.name, v.lang, v.options, name, schema)
+			})
+		}
+	}
+}
+
+func testGolden(t *testing.T, dir string, lang string, options GeneratorOptions, name string, schema *Schema) {
+	generator, err := NewGenerator(lang, options)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	root := filepath.Join("testdata", "golden", dir)
+	got, err := generator.Generate(name+LanguageExtension(lang), schema)
+	if err != nil {
+		got = Files{name + ".error": []byte(err.Error() + "\n")}
+	}
+
+	if *update {
+		for _, path := range goldenFiles(t, root, name) {
+			if err := os.Remove(filepath.Join(root, path)); err != nil {
+				t.Fatal(err)
+			}
+		}
+		for _, path := range got.Paths() {
+			p := filepath.Join(root, path)
+			if err := os.MkdirAll(filepath.Dir(p), 0755); err != nil {
+				t.Fatal(err)
+			}
+			if err := os.WriteFile(p, got[path], 0644); err != nil {
+				t.Fatal(err)
+			}
+		}
+		return
+	}
+
+	want := goldenFiles(t, root, name)
+	if paths := got.Paths(); strings.Join(paths, "\n") != strings.Join(want, "\n") {
+		t.Fatalf("generated files %v, golden files %v", paths, want)
+	}
+
+	for _, path := range want {
+		data, err := os.ReadFile(filepath.Join(root, path))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(got[path], data) {
+			t.Errorf("%s differs from %s:\n%s", path, filepath.Join(root, path), diffLines(string(data), string(got[path])))
+		}
+	}
+}
+
+// goldenFiles returns the paths below root generated for the fixture name:
+// name plus an extension, or any file in the directory name.
+func goldenFiles(t *testing.T, root string, name string) []string {
+	var paths []string
+	err := filepath.WalkDir(root, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			if p == root && os.IsNotExist(err) {
+				return filepath.SkipDir
+			}
+			return err
+		}
+		if d.IsDir() {
+			return nil
+		}
+
+		rel, err := filepath.Rel(root, p)
+		if err != nil {
+			return err
+		}
+		first, _, nested := strings.Cut(filepath.ToSlash(rel), "/")
+		if nested && first == name || !nested && strings.HasPrefix(first, name+".") {
+			paths = append(paths, rel)
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	sort.Strings(paths)
+
+	return paths
+}
+
+// diffLines describes the first line where want and got differ.
+func diffLines(want, got string) string {
+	wantLines := strings.Split(want, "\n")
+	gotLines := strings.Split(got, "\n")
+	for i := 0; i < len(wantLines) || i < len(gotLines); i++ {
+		var w, g string
+		if i < len(wantLines) {
+			w = wantLines[i]
+		}
+		if i < len(gotLines) {
+			g = gotLines[i]
+		}
+		if w != g {
+			return "line " + strconv.Itoa(i+1) + ":\n- " + w + "\n+ " + g
+		}
+	}
+
+	return "no line differs"
+}
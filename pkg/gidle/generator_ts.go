@@ -0,0 +1,568 @@
+package gidle
+
+import (
+	"bytes"
+	"strconv"
+	"strings"
+)
+
+const (
+	TypeScriptModuleNamespace = "namespace"
+	TypeScriptModuleESM       = "esm"
+)
+
+const (
+	TypeScriptMapMap    = "map"
+	TypeScriptMapRecord = "record"
+)
+
+// typeScriptCodecHelpers are emitted once per file and used by the generated decoders and encoders.
+const typeScriptCodecHelpers = `function gidleFail(path: string, expected: string, value: unknown): never {
+	throw new Error(` + "`${path}: expected ${expected}, got ${JSON.stringify(value)}`" + `);
+}
+
+function gidleDecodeString(value: unknown, path: string): string {
+	if (typeof value !== "string") {
+		return gidleFail(path, "string", value);
+	}
+	return value;
+}
+
+function gidleDecodeBoolean(value: unknown, path: string): boolean {
+	if (typeof value !== "boolean") {
+		return gidleFail(path, "boolean", value);
+	}
+	return value;
+}
+
+function gidleDecodeNumber(value: unknown, path: string): number {
+	if (typeof value !== "number") {
+		return gidleFail(path, "number", value);
+	}
+	return value;
+}
+
+function gidleDecodeInteger(value: unknown, path: string, min: number, max: number): number {
+	if (typeof value !== "number" || !Number.isInteger(value) || value < min || value > max) {
+		return gidleFail(path, ` + "`integer in [${min}, ${max}]`" + `, value);
+	}
+	return value;
+}
+
+function gidleDecodeObject(value: unknown, path: string): Record<string, unknown> {
+	if (typeof value !== "object" || value === null || Array.isArray(value)) {
+		return gidleFail(path, "object", value);
+	}
+	return value as Record<string, unknown>;
+}
+
+function gidleDecodeArray<T>(value: unknown, path: string, decode: (value: unknown, path: string) => T): Array<T> {
+	if (!Array.isArray(value)) {
+		return gidleFail(path, "array", value);
+	}
+	return value.map((v, i) => decode(v, ` + "`${path}[${i}]`" + `));
+}
+
+function gidleDecodeMap<K, V>(value: unknown, path: string, decodeKey: (key: string, path: string) => K, decodeValue: (value: unknown, path: string) => V): Map<K, V> {
+	const result = new Map<K, V>();
+	for (const [k, v] of Object.entries(gidleDecodeObject(value, path))) {
+		const p = ` + "`${path}[${JSON.stringify(k)}]`" + `;
+		result.set(decodeKey(k, p), decodeValue(v, p));
+	}
+	return result;
+}
+
+function gidleDecodeRecord<K extends string | number, V>(value: unknown, path: string, decodeKey: (key: string, path: string) => K, decodeValue: (value: unknown, path: string) => V): Record<K, V> {
+	const result = {} as Record<K, V>;
+	for (const [k, v] of Object.entries(gidleDecodeObject(value, path))) {
+		const p = ` + "`${path}[${JSON.stringify(k)}]`" + `;
+		result[decodeKey(k, p)] = decodeValue(v, p);
+	}
+	return result;
+}
+
+function gidleEncodeMap<K, V>(value: Map<K, V>, encode: (value: V) => unknown): Record<string, unknown> {
+	const result: Record<string, unknown> = {};
+	value.forEach((v, k) => {
+		result[String(k)] = encode(v);
+	});
+	return result;
+}
+
+function gidleEncodeRecord<V>(value: Record<string | number, V>, encode: (value: V) => unknown): Record<string, unknown> {
+	const result: Record<string, unknown> = {};
+	for (const [k, v] of Object.entries(value)) {
+		result[k] = encode(v);
+	}
+	return result;
+}
+
+`
+
+type TypeScriptGenerator struct {
+	buffer *bytes.Buffer
+	values *Grammar
+
+	// ModuleStyle is TypeScriptModuleNamespace to wrap the output in a namespace per
+	// package segment, or TypeScriptModuleESM to export everything at the top level.
+	ModuleStyle string
+	// MapStyle is TypeScriptMapMap to generate maps as Map<K, V>, or TypeScriptMapRecord for Record<K, V>.
+	MapStyle string
+}
+
+func NewTypeScriptGenerator() *TypeScriptGenerator {
+	return &TypeScriptGenerator{
+		buffer: bytes.NewBuffer(nil),
+	}
+}
+
+func (t *TypeScriptGenerator) Generate(outPath string, values *Grammar) (Files, error) {
+	t.buffer.Reset()
+	t.values = values
+
+	for i := range values.Imports {
+		imp := &values.Imports[i]
+		importPath := ImportOutputPath(imp, "")
+		if !strings.HasPrefix(importPath, ".") {
+			importPath = "./" + importPath
+		}
+
+		t.buffer.WriteString("import * as ")
+		t.buffer.WriteString(ImportQualifier(imp))
+		t.buffer.WriteString(" from \"")
+		t.buffer.WriteString(importPath)
+		t.buffer.WriteString("\";\n")
+	}
+	if len(values.Imports) > 0 {
+		t.buffer.WriteString("\n")
+	}
+
+	var names []string
+	if t.ModuleStyle != TypeScriptModuleESM {
+		names = values.Package.Names
+	}
+
+	for _, name := range names {
+		t.buffer.WriteString("export namespace ")
+		t.buffer.WriteString(name)
+		t.buffer.WriteString(" {\n")
+	}
+	t.buffer.WriteString("\n")
+
+	for _, entry := range values.Entries {
+		if entry.Enum != nil || entry.Object != nil {
+			t.buffer.WriteString(typeScriptCodecHelpers)
+			break
+		}
+	}
+
+	for _, entry := range values.Entries {
+		if entry.Const != nil {
+			t.generateConst(entry.Const)
+		} else if entry.Enum != nil {
+			t.generateEnum(entry.Enum)
+		} else if entry.Object != nil {
+			t.generateObject(entry.Object)
+		}
+	}
+
+	t.buffer.WriteString("\n")
+	for range names {
+		t.buffer.WriteString("}\n")
+	}
+
+	return Files{outPath: bytes.Clone(t.buffer.Bytes())}, nil
+}
+
+func (t *TypeScriptGenerator) generatePrimitiveValue(value *PrimitiveValue) {
+	if value.StringValue != nil {
+		t.buffer.WriteString("\"")
+		t.buffer.WriteString(*value.StringValue)
+		t.buffer.WriteString("\"")
+	} else if value.IntValue != nil {
+		t.buffer.WriteString(strconv.FormatInt(*value.IntValue, 10))
+	} else if value.FloatValue != nil {
+		t.buffer.WriteString(strconv.FormatFloat(*value.FloatValue, 'f', -1, 64))
+	} else if value.BoolValue != nil {
+		t.buffer.WriteString(strconv.FormatBool(bool(*value.BoolValue)))
+	} else {
+		t.buffer.WriteString("null")
+	}
+}
+
+func (t *TypeScriptGenerator) generateConst(constant *Const) {
+	for _, f := range constant.Fields {
+		writeBlockDoc(t.buffer, "", joinDoc(constant.Doc, f.Doc))
+		t.buffer.WriteString("export const ")
+		t.buffer.WriteString(constant.Name)
+		t.buffer.WriteString("_")
+		t.buffer.WriteString(f.Name)
+		t.buffer.WriteString(" = ")
+		t.generatePrimitiveValue(&f.Value)
+		t.buffer.WriteString(";\n")
+	}
+}
+
+func (t *TypeScriptGenerator) generateEnum(enum *Enum) {
+	writeBlockDoc(t.buffer, "", enum.Doc)
+	t.buffer.WriteString("export enum ")
+	t.buffer.WriteString(enum.Name)
+	t.buffer.WriteString(" {\n")
+	for _, v := range enum.Body {
+		writeBlockDoc(t.buffer, "\t", v.Doc)
+		t.buffer.WriteString("\t")
+		t.buffer.WriteString(v.Name)
+		t.buffer.WriteString(" = ")
+		t.generatePrimitiveValue(&v.Value)
+		t.buffer.WriteString(",\n")
+	}
+	t.buffer.WriteString("}\n\n")
+
+	t.buffer.WriteString("export function indexOf")
+	t.buffer.WriteString(enum.Name)
+	t.buffer.WriteString("(value: ")
+	t.buffer.WriteString(enum.Name)
+	t.buffer.WriteString("): number {\n")
+	t.buffer.WriteString("\t switch (value) {\n")
+	for i, v := range enum.Body {
+		t.buffer.WriteString("\t\t case ")
+		t.buffer.WriteString(enum.Name)
+		t.buffer.WriteString(".")
+		t.buffer.WriteString(v.Name)
+		t.buffer.WriteString(":\n")
+		t.buffer.WriteString("\t\t\t return ")
+		t.buffer.WriteString(strconv.Itoa(i))
+		t.buffer.WriteString(";\n")
+	}
+	t.buffer.WriteString("\t\t default:\n")
+	t.buffer.WriteString("\t\t\t return -1;\n")
+	t.buffer.WriteString("\t }\n")
+	t.buffer.WriteString("}\n\n")
+
+	t.buffer.WriteString("export function get")
+	t.buffer.WriteString(enum.Name)
+	t.buffer.WriteString("(index: number): ")
+	t.buffer.WriteString(enum.Name)
+	t.buffer.WriteString(" {\n")
+	t.buffer.WriteString("\t switch (index) {\n")
+	for i, v := range enum.Body {
+		t.buffer.WriteString("\t\t case ")
+		t.buffer.WriteString(strconv.Itoa(i))
+		t.buffer.WriteString(":\n")
+		t.buffer.WriteString("\t\t\t return ")
+		t.buffer.WriteString(enum.Name)
+		t.buffer.WriteString(".")
+		t.buffer.WriteString(v.Name)
+		t.buffer.WriteString(";\n")
+	}
+	t.buffer.WriteString("\t\t default:\n")
+	t.buffer.WriteString("\t\t\t throw new Error(\"unknown enum value\");\n")
+	t.buffer.WriteString("\t }\n")
+	t.buffer.WriteString("}\n\n")
+
+	t.buffer.WriteString("export function decode")
+	t.buffer.WriteString(enum.Name)
+	t.buffer.WriteString("(json: unknown, path: string = \"$\"): ")
+	t.buffer.WriteString(enum.Name)
+	t.buffer.WriteString(" {\n")
+	t.buffer.WriteString("\tswitch (json) {\n")
+	for _, v := range enum.Body {
+		t.buffer.WriteString("\t\tcase ")
+		t.generatePrimitiveValue(&v.Value)
+		t.buffer.WriteString(":\n")
+	}
+	if len(enum.Body) > 0 {
+		t.buffer.WriteString("\t\t\treturn json as ")
+		t.buffer.WriteString(enum.Name)
+		t.buffer.WriteString(";\n")
+	}
+	t.buffer.WriteString("\t\tdefault:\n")
+	t.buffer.WriteString("\t\t\treturn gidleFail(path, \"")
+	t.buffer.WriteString(enum.Name)
+	t.buffer.WriteString("\", json);\n")
+	t.buffer.WriteString("\t}\n")
+	t.buffer.WriteString("}\n\n")
+
+	t.buffer.WriteString("export function encode")
+	t.buffer.WriteString(enum.Name)
+	t.buffer.WriteString("(value: ")
+	t.buffer.WriteString(enum.Name)
+	t.buffer.WriteString("): unknown {\n")
+	t.buffer.WriteString("\treturn value;\n")
+	t.buffer.WriteString("}\n\n")
+}
+
+func (t *TypeScriptGenerator) generateType(ty *Type) {
+	if ty.PrimitiveType != nil {
+		t.generatePrimitiveType(ty.PrimitiveType)
+	} else if ty.ListType != nil {
+		t.generateListType(ty.ListType)
+	} else if ty.MapType != nil {
+		t.generateMapType(ty.MapType)
+	} else if ty.Identity != nil {
+		t.generateIdentity(*ty.Identity, "")
+	} else {
+		panic("unknown type")
+	}
+}
+
+// generateIdentity writes a reference to the declaration named prefix + the name of identity,
+// e.g. decodePerson for the prefix decode.
+func (t *TypeScriptGenerator) generateIdentity(identity string, prefix string) {
+	qualifier, name := SplitIdentity(identity)
+	if qualifier != "" {
+		t.buffer.WriteString(qualifier)
+		t.buffer.WriteString(".")
+		if imp := LookupImport(t.values, qualifier); imp != nil && imp.Grammar != nil && t.ModuleStyle != TypeScriptModuleESM {
+			for _, n := range imp.Grammar.Package.Names {
+				t.buffer.WriteString(n)
+				t.buffer.WriteString(".")
+			}
+		}
+	}
+	t.buffer.WriteString(prefix)
+	t.buffer.WriteString(name)
+}
+
+func (t *TypeScriptGenerator) generatePrimitiveType(ty *PrimitiveType) {
+	switch ty.Type {
+	case "int8", "int16", "int32", "int64", "uint8", "uint16", "uint32", "uint64", "float32", "float64":
+		t.buffer.WriteString("number")
+	case "string":
+		t.buffer.WriteString("string")
+	case "bool":
+		t.buffer.WriteString("boolean")
+	default:
+		panic("unknown primitive type")
+	}
+}
+
+func (t *TypeScriptGenerator) generateListType(ty *ListType) {
+	t.buffer.WriteString("Array<")
+	t.generateType(&ty.ElementType)
+	t.buffer.WriteString(">")
+}
+
+func (t *TypeScriptGenerator) generateMapType(ty *MapType) {
+	if t.MapStyle == TypeScriptMapRecord {
+		t.buffer.WriteString("Record<")
+		if ty.KeyType.Type == "bool" {
+			t.buffer.WriteString("string")
+		} else {
+			t.generatePrimitiveType(&ty.KeyType)
+		}
+	} else {
+		t.buffer.WriteString("Map<")
+		t.generatePrimitiveType(&ty.KeyType)
+	}
+	t.buffer.WriteString(",")
+	t.generateType(&ty.ValueType)
+	t.buffer.WriteString(">")
+}
+
+func (t *TypeScriptGenerator) generateObject(object *Object) {
+	writeBlockDoc(t.buffer, "", object.Doc)
+	t.buffer.WriteString("export interface ")
+	t.buffer.WriteString(object.Name)
+	t.buffer.WriteString(" {\n")
+
+	for _, f := range object.Fields {
+		writeBlockDoc(t.buffer, "\t", f.Doc)
+		t.buffer.WriteString("\t")
+		t.buffer.WriteString(f.Name)
+		if IsOptionalField(&f) {
+			t.buffer.WriteString("?")
+		}
+		t.buffer.WriteString(": ")
+		t.generateType(&f.Type)
+		t.buffer.WriteString(";\n")
+	}
+
+	t.buffer.WriteString("}\n\n")
+
+	t.buffer.WriteString("export function decode")
+	t.buffer.WriteString(object.Name)
+	t.buffer.WriteString("(json: unknown, path: string = \"$\"): ")
+	t.buffer.WriteString(object.Name)
+	t.buffer.WriteString(" {\n")
+	if len(object.Fields) > 0 {
+		t.buffer.WriteString("\tconst object = gidleDecodeObject(json, path);\n")
+	} else {
+		t.buffer.WriteString("\tgidleDecodeObject(json, path);\n")
+	}
+	t.buffer.WriteString("\treturn {\n")
+	for _, f := range object.Fields {
+		name := JSONName(&f)
+		value := "object[" + strconv.Quote(name) + "]"
+		path := "`${path}." + name + "`"
+		if name != f.Name {
+			// The JSON name need not be an identifier, so it is quoted like a map key.
+			path = "path + " + strconv.Quote("["+strconv.Quote(name)+"]")
+		}
+
+		t.buffer.WriteString("\t\t")
+		t.buffer.WriteString(f.Name)
+		t.buffer.WriteString(": ")
+		if IsOptionalField(&f) {
+			t.buffer.WriteString(value)
+			t.buffer.WriteString(" === undefined || ")
+			t.buffer.WriteString(value)
+			t.buffer.WriteString(" === null ? undefined : ")
+		}
+		t.generateDecodeExpression(&f.Type, value, path)
+		t.buffer.WriteString(",\n")
+	}
+	t.buffer.WriteString("\t};\n")
+	t.buffer.WriteString("}\n\n")
+
+	t.buffer.WriteString("export function encode")
+	t.buffer.WriteString(object.Name)
+	t.buffer.WriteString("(value: ")
+	t.buffer.WriteString(object.Name)
+	t.buffer.WriteString("): unknown {\n")
+	t.buffer.WriteString("\treturn {\n")
+	for _, f := range object.Fields {
+		value := "value." + f.Name
+
+		t.buffer.WriteString("\t\t")
+		if name := JSONName(&f); name != f.Name {
+			t.buffer.WriteString(strconv.Quote(name))
+		} else {
+			t.buffer.WriteString(f.Name)
+		}
+		t.buffer.WriteString(": ")
+		if IsOptionalField(&f) && t.needsEncoding(&f.Type) {
+			t.buffer.WriteString(value)
+			t.buffer.WriteString(" === undefined ? undefined : ")
+		}
+		t.generateEncodeExpression(&f.Type, value)
+		t.buffer.WriteString(",\n")
+	}
+	t.buffer.WriteString("\t};\n")
+	t.buffer.WriteString("}\n\n")
+}
+
+// generateDecodeExpression writes an expression decoding the JSON value expr to ty,
+// reporting errors at the path given by the expression path.
+func (t *TypeScriptGenerator) generateDecodeExpression(ty *Type, expr string, path string) {
+	if ty.PrimitiveType != nil {
+		switch ty.PrimitiveType.Type {
+		case "string":
+			t.buffer.WriteString("gidleDecodeString(")
+		case "bool":
+			t.buffer.WriteString("gidleDecodeBoolean(")
+		case "float32", "float64":
+			t.buffer.WriteString("gidleDecodeNumber(")
+		default:
+			t.buffer.WriteString("gidleDecodeInteger(")
+		}
+		t.buffer.WriteString(expr)
+		t.buffer.WriteString(", ")
+		t.buffer.WriteString(path)
+		if min, max, ok := typeScriptIntegerBounds(ty.PrimitiveType.Type); ok {
+			t.buffer.WriteString(", ")
+			t.buffer.WriteString(min)
+			t.buffer.WriteString(", ")
+			t.buffer.WriteString(max)
+		}
+		t.buffer.WriteString(")")
+	} else if ty.ListType != nil {
+		t.buffer.WriteString("gidleDecodeArray(")
+		t.buffer.WriteString(expr)
+		t.buffer.WriteString(", ")
+		t.buffer.WriteString(path)
+		t.buffer.WriteString(", (v, p) => ")
+		t.generateDecodeExpression(&ty.ListType.ElementType, "v", "p")
+		t.buffer.WriteString(")")
+	} else if ty.MapType != nil {
+		if t.MapStyle == TypeScriptMapRecord {
+			t.buffer.WriteString("gidleDecodeRecord(")
+		} else {
+			t.buffer.WriteString("gidleDecodeMap(")
+		}
+		t.buffer.WriteString(expr)
+		t.buffer.WriteString(", ")
+		t.buffer.WriteString(path)
+		t.buffer.WriteString(", (k, p) => ")
+		switch ty.MapType.KeyType.Type {
+		case "string":
+			t.buffer.WriteString("k")
+		case "bool":
+			if t.MapStyle == TypeScriptMapRecord {
+				t.buffer.WriteString("k === \"true\" || k === \"false\" ? k : gidleFail(p, \"boolean key\", k)")
+			} else {
+				t.buffer.WriteString("k === \"true\" ? true : k === \"false\" ? false : gidleFail(p, \"boolean key\", k)")
+			}
+		default:
+			t.generateDecodeExpression(&Type{PrimitiveType: &ty.MapType.KeyType}, "Number(k)", "p")
+		}
+		t.buffer.WriteString(", (v, p) => ")
+		t.generateDecodeExpression(&ty.MapType.ValueType, "v", "p")
+		t.buffer.WriteString(")")
+	} else if ty.Identity != nil {
+		t.generateIdentity(*ty.Identity, "decode")
+		t.buffer.WriteString("(")
+		t.buffer.WriteString(expr)
+		t.buffer.WriteString(", ")
+		t.buffer.WriteString(path)
+		t.buffer.WriteString(")")
+	}
+}
+
+// generateEncodeExpression writes an expression converting expr of type ty to a value JSON.stringify accepts.
+func (t *TypeScriptGenerator) generateEncodeExpression(ty *Type, expr string) {
+	if !t.needsEncoding(ty) {
+		t.buffer.WriteString(expr)
+	} else if ty.ListType != nil {
+		t.buffer.WriteString(expr)
+		t.buffer.WriteString(".map((v) => ")
+		t.generateEncodeExpression(&ty.ListType.ElementType, "v")
+		t.buffer.WriteString(")")
+	} else if ty.MapType != nil {
+		if t.MapStyle == TypeScriptMapRecord {
+			t.buffer.WriteString("gidleEncodeRecord(")
+		} else {
+			t.buffer.WriteString("gidleEncodeMap(")
+		}
+		t.buffer.WriteString(expr)
+		t.buffer.WriteString(", (v) => ")
+		t.generateEncodeExpression(&ty.MapType.ValueType, "v")
+		t.buffer.WriteString(")")
+	} else if ty.Identity != nil {
+		t.generateIdentity(*ty.Identity, "encode")
+		t.buffer.WriteString("(")
+		t.buffer.WriteString(expr)
+		t.buffer.WriteString(")")
+	}
+}
+
+// needsEncoding reports whether values of ty must be converted before JSON.stringify.
+func (t *TypeScriptGenerator) needsEncoding(ty *Type) bool {
+	if ty.PrimitiveType != nil {
+		return false
+	} else if ty.ListType != nil {
+		return t.needsEncoding(&ty.ListType.ElementType)
+	} else if ty.MapType != nil {
+		return t.MapStyle != TypeScriptMapRecord || t.needsEncoding(&ty.MapType.ValueType)
+	} else if ty.Identity != nil {
+		_, entry := LookupEntry(t.values, *ty.Identity)
+		return entry == nil || entry.Enum == nil
+	}
+
+	return false
+}
+
+// typeScriptIntegerBounds returns the range of integer type t as TypeScript expressions.
+func typeScriptIntegerBounds(t string) (min string, max string, ok bool) {
+	switch t {
+	case "int64":
+		return "Number.MIN_SAFE_INTEGER", "Number.MAX_SAFE_INTEGER", true
+	case "uint64":
+		return "0", "Number.MAX_SAFE_INTEGER", true
+	case "int8", "int16", "int32", "uint8", "uint16", "uint32":
+		lo, hi := integerRange(t)
+		return strconv.FormatInt(lo, 10), strconv.FormatUint(hi, 10), true
+	default:
+		return "", "", false
+	}
+}
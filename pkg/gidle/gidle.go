@@ -0,0 +1,67 @@
+// Package gidle parses and checks gidle schemas and generates code from them.
+//
+//	schema, err := gidle.ParseFile("person.gidle")
+//	if err != nil {
+//		return err
+//	}
+//	generator, err := gidle.NewGenerator(gidle.LanguageGo, nil)
+//	if err != nil {
+//		return err
+//	}
+//	files, err := generator.Generate("person.go", schema)
+//	if err != nil {
+//		return err
+//	}
+//	return os.WriteFile("person.go", files["person.go"], 0644)
+package gidle
+
+import (
+	"io"
+)
+
+// Schema is a parsed gidle file.
+type Schema = Grammar
+
+// Parse reads and checks a schema. Imports are resolved relative to files,
+// so a schema read by Parse cannot import others; use ParseFile for those.
+func Parse(r io.Reader) (*Schema, error) {
+	values, err := NewParser().Parse("", r)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(values.Imports) > 0 {
+		var errs CheckErrors
+		for _, imp := range values.Imports {
+			errs = append(errs, &CheckError{Pos: imp.Pos, Message: "cannot import " + imp.Path + ": imports need a file, use ParseFile"})
+		}
+		return nil, errs
+	}
+
+	if err := NewChecker().Check(values); err != nil {
+		return nil, err
+	}
+
+	return values, nil
+}
+
+// ParseFile loads and checks the schema at path and every schema it imports.
+func ParseFile(path string) (*Schema, error) {
+	loader := NewLoader()
+	values, err := loader.Load(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var errs CheckErrors
+	for _, grammar := range loader.Grammars() {
+		if err := NewChecker().Check(grammar); err != nil {
+			errs = append(errs, err.(CheckErrors)...)
+		}
+	}
+	if len(errs) > 0 {
+		return nil, errs
+	}
+
+	return values, nil
+}
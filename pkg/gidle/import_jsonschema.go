@@ -0,0 +1,742 @@
+package gidle
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// jsonSchemaIgnored are keywords that only annotate a schema and are dropped without a note.
+var jsonSchemaIgnored = map[string]bool{
+	"$schema": true, "$id": true, "$comment": true, "title": true, "examples": true,
+	"default": true, "deprecated": true, "readOnly": true, "writeOnly": true,
+}
+
+// jsonSchemaDecl is an object, enum or const block of an imported schema waiting to be written.
+type jsonSchemaDecl struct {
+	name   string
+	schema jsonSchemaObject
+	// consts are the `const` definitions of a const block, by field name.
+	consts []jsonSchemaMember
+}
+
+type jsonSchemaImporter struct {
+	defs      jsonSchemaObject
+	names     map[string]string
+	used      map[string]bool
+	resolving map[string]bool
+	queue     []jsonSchemaDecl
+	notes     []string
+}
+
+// ImportJSONSchema converts a JSON Schema document to a formatted .gidle file of package pkg.
+// The document becomes an object called name if it has properties, and each definition of `$defs`
+// or `definitions` an object, enum or const. Properties declared inline get objects and enums named
+// after them. It returns a note for every construct that has no equivalent in gidle, such as
+// `oneOf` or `pattern`, and an error when data is not a JSON Schema document.
+func ImportJSONSchema(data []byte, pkg string, name string) ([]byte, []string, error) {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	document, err := decodeJSONSchema(decoder)
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid JSON: %w", err)
+	}
+	if _, err := decoder.Token(); err != io.EOF {
+		return nil, nil, errors.New("invalid JSON: more than one value")
+	}
+	root, ok := document.(jsonSchemaObject)
+	if !ok {
+		return nil, nil, errors.New("a JSON Schema document must be an object")
+	}
+
+	im := &jsonSchemaImporter{
+		names:     make(map[string]string),
+		used:      make(map[string]bool),
+		resolving: make(map[string]bool),
+	}
+	for _, keyword := range []string{"$defs", "definitions"} {
+		if defs, ok := root.get(keyword); ok {
+			if defs, ok := defs.(jsonSchemaObject); ok {
+				im.defs = append(im.defs, defs...)
+			}
+		}
+	}
+
+	if _, ok := root.get("properties"); ok {
+		im.used[name] = true
+		im.queue = append(im.queue, jsonSchemaDecl{name: name, schema: root})
+	}
+
+	consts := make(map[string]int)
+	for _, def := range im.defs {
+		schema, ok := def.Value.(jsonSchemaObject)
+		if !ok {
+			continue
+		}
+		if _, ok := schema.get("const"); ok {
+			group, field, found := strings.Cut(def.Key, "_")
+			if !found {
+				group, field = "Constants", def.Key
+			}
+			group = im.declName(group)
+			if i, ok := consts[group]; ok {
+				im.queue[i].consts = append(im.queue[i].consts, jsonSchemaMember{field, schema})
+				continue
+			}
+			consts[group] = len(im.queue)
+			im.used[group] = true
+			im.queue = append(im.queue, jsonSchemaDecl{name: group, consts: []jsonSchemaMember{{field, schema}}})
+			continue
+		}
+		// Enums without a value gidle can hold are written in place as their primitive type.
+		if !isJSONSchemaDecl(schema) || isJSONSchemaEnum(schema) && !hasJSONSchemaEnumValue(schema) {
+			continue
+		}
+
+		declName := im.declName(def.Key)
+		if im.used[declName] {
+			im.notef("%s: renamed to %sDef, %s is already declared", def.Key, declName, declName)
+			declName += "Def"
+		}
+		im.names[def.Key] = declName
+		im.used[declName] = true
+		im.queue = append(im.queue, jsonSchemaDecl{name: declName, schema: schema})
+	}
+	if len(im.queue) == 0 {
+		return nil, im.notes, errors.New("the document has no properties and no object, enum or const definitions")
+	}
+
+	var buffer bytes.Buffer
+	buffer.WriteString("package " + pkg + "\n")
+	for len(im.queue) > 0 {
+		decl := im.queue[0]
+		im.queue = im.queue[1:]
+		buffer.WriteString("\n")
+		switch {
+		case decl.consts != nil:
+			im.writeConst(&buffer, decl)
+		case isJSONSchemaEnum(decl.schema):
+			im.writeEnum(&buffer, decl.name, decl.schema)
+		default:
+			im.writeObject(&buffer, decl.name, decl.schema)
+		}
+	}
+
+	formatted, err := Format(pkg+".gidle", buffer.Bytes())
+	if err != nil {
+		return nil, im.notes, fmt.Errorf("the converted schema does not parse: %w", err)
+	}
+
+	return formatted, im.notes, nil
+}
+
+func (im *jsonSchemaImporter) notef(format string, args ...any) {
+	im.notes = append(im.notes, fmt.Sprintf(format, args...))
+}
+
+// unsupported notes the keywords of schema other than handled and annotations.
+func (im *jsonSchemaImporter) unsupported(schema jsonSchemaObject, where string, handled ...string) {
+	for _, m := range schema {
+		if jsonSchemaIgnored[m.Key] || m.Key == "description" || m.Key == "$defs" || m.Key == "definitions" {
+			continue
+		}
+		known := false
+		for _, h := range handled {
+			known = known || h == m.Key
+		}
+		if !known {
+			im.notef("%s: %s is not supported and is dropped", where, m.Key)
+		}
+	}
+}
+
+func (im *jsonSchemaImporter) writeObject(buffer *bytes.Buffer, name string, schema jsonSchemaObject) {
+	im.unsupported(schema, name, "type", "properties", "required", "additionalProperties")
+	if ap, ok := schema.get("additionalProperties"); ok && ap != false {
+		im.notef("%s: additionalProperties next to properties is not supported and is dropped", name)
+	}
+
+	writeJSONSchemaDoc(buffer, "", schema)
+	buffer.WriteString("object " + name + " {\n")
+
+	required := make(map[string]bool)
+	if list, ok := schema.get("required"); ok {
+		if list, ok := list.([]any); ok {
+			for _, key := range list {
+				if key, ok := key.(string); ok {
+					required[key] = true
+				}
+			}
+		}
+	}
+
+	properties, _ := schema.get("properties")
+	members, _ := properties.(jsonSchemaObject)
+	names := make(map[string]bool)
+	for _, m := range members {
+		field := fieldName(m.Key)
+		if field == "" || field[0] >= '0' && field[0] <= '9' {
+			field = "field_" + field
+		}
+		for names[field] {
+			field += "_"
+		}
+		names[field] = true
+
+		t, nullable := im.typeOf(m.Value, field, name, name+"."+field)
+
+		if property, ok := m.Value.(jsonSchemaObject); ok {
+			writeJSONSchemaDoc(buffer, FormatIndent, property)
+		}
+		buffer.WriteString(FormatIndent)
+		if field != m.Key {
+			buffer.WriteString("@json(" + strconv.Quote(m.Key) + ") ")
+		}
+		if !required[m.Key] || nullable {
+			buffer.WriteString("optional ")
+		}
+		buffer.WriteString(t + " " + field + "\n")
+	}
+
+	buffer.WriteString("}\n")
+}
+
+func (im *jsonSchemaImporter) writeEnum(buffer *bytes.Buffer, name string, schema jsonSchemaObject) {
+	values, _ := schema.get("enum")
+	list, _ := values.([]any)
+
+	// The values of the type of the first value, written as in a schema.
+	var t string
+	var written []string
+	for _, v := range list {
+		var valueType, text string
+		switch v := v.(type) {
+		case nil:
+			continue
+		case string:
+			valueType, text = "string", strconv.Quote(v)
+		case bool:
+			valueType, text = "bool", strconv.FormatBool(v)
+		case json.Number:
+			if _, err := strconv.ParseUint(v.String(), 10, 64); err != nil {
+				im.notef("%s: the value %s is not supported and is dropped, only integers of 0 or more are supported", name, v)
+				continue
+			}
+			valueType, text = "integer", v.String()
+		default:
+			im.notef("%s: the value %v is not a string, integer or bool and is dropped", name, v)
+			continue
+		}
+		if t == "" {
+			t = valueType
+		} else if t != valueType {
+			im.notef("%s: the value %s is dropped, the enum has %s values", name, text, t)
+			continue
+		}
+		written = append(written, text)
+	}
+
+	switch t {
+	case "integer":
+		im.unsupported(schema, name, "type", "enum", "format", "minimum", "maximum")
+		t = im.primitiveType(schema.without("enum"), name)
+		if _, ok := schema.get("type"); !ok {
+			keys := &inferShape{}
+			for _, v := range written {
+				keys.addNumber(v)
+			}
+			t = numberType(keys)
+		}
+	default:
+		im.unsupported(schema, name, "type", "enum")
+	}
+
+	writeJSONSchemaDoc(buffer, "", schema)
+	buffer.WriteString("enum " + name + " for " + t + " {\n")
+	names := make(map[string]bool)
+	for _, v := range written {
+		n := strings.ToUpper(fieldName(v))
+		if n == "" || n[0] >= '0' && n[0] <= '9' {
+			n = "VALUE_" + n
+		}
+		for base, i := n, 2; names[n]; i++ {
+			n = base + "_" + strconv.Itoa(i)
+		}
+		names[n] = true
+		buffer.WriteString(FormatIndent + n + " = " + v + "\n")
+	}
+	buffer.WriteString("}\n")
+}
+
+func (im *jsonSchemaImporter) writeConst(buffer *bytes.Buffer, decl jsonSchemaDecl) {
+	var t string
+	var fields bytes.Buffer
+	for _, c := range decl.consts {
+		schema := c.Value.(jsonSchemaObject)
+		where := decl.name + "." + c.Key
+		value, _ := schema.get("const")
+
+		var valueType, text string
+		switch v := value.(type) {
+		case string:
+			valueType, text = "string", strconv.Quote(v)
+		case bool:
+			valueType, text = "bool", strconv.FormatBool(v)
+		case json.Number:
+			if strings.HasPrefix(v.String(), "-") {
+				im.notef("%s: the negative value %s is not supported and is dropped", where, v)
+				continue
+			}
+			valueType, text = im.primitiveType(schema.without("const"), where), v.String()
+			if strings.ContainsAny(text, ".eE") != (valueType == "float32" || valueType == "float64") {
+				valueType = "float64"
+			}
+		default:
+			im.notef("%s: the value %v is not a string, number or bool and is dropped", where, value)
+			continue
+		}
+		if t == "" {
+			t = valueType
+		} else if t != valueType {
+			im.notef("%s: the %s value is dropped, %s holds %s values", where, valueType, decl.name, t)
+			continue
+		}
+
+		name := c.Key
+		if !isIdentifier(name) {
+			name = "VALUE_" + strings.ToUpper(fieldName(name))
+		}
+		writeJSONSchemaDoc(&fields, FormatIndent, schema)
+		fields.WriteString(FormatIndent + name + " = " + text + "\n")
+	}
+	if t == "" {
+		im.notef("%s: every value was dropped, the const is empty", decl.name)
+		t = "string"
+	}
+
+	buffer.WriteString("const " + decl.name + " for " + t + " {\n")
+	buffer.Write(fields.Bytes())
+	buffer.WriteString("}\n")
+}
+
+// typeOf returns the type of the values schema describes, declaring objects and enums named after
+// hint in parent for inline schemas, and whether the values may be null. where names the place in notes.
+func (im *jsonSchemaImporter) typeOf(value any, hint string, parent string, where string) (string, bool) {
+	schema, ok := value.(jsonSchemaObject)
+	if !ok || len(schema) == 0 {
+		im.notef("%s: any value is allowed, using string", where)
+		return "string", false
+	}
+
+	if ref, ok := schema.get("$ref"); ok {
+		im.unsupported(schema, where, "$ref")
+		return im.reference(ref, where), false
+	}
+
+	for _, keyword := range []string{"anyOf", "oneOf", "allOf"} {
+		list, ok := schema.get(keyword)
+		if !ok {
+			continue
+		}
+		im.unsupported(schema, where, keyword)
+
+		alternatives, _ := list.([]any)
+		var others []any
+		nullable := false
+		for _, a := range alternatives {
+			if a, ok := a.(jsonSchemaObject); ok && len(a) == 1 {
+				if t, _ := a.get("type"); t == "null" && keyword != "allOf" {
+					nullable = true
+					continue
+				}
+			}
+			others = append(others, a)
+		}
+		if len(others) != 1 {
+			im.notef("%s: %s of %d schemas is not supported, using string", where, keyword, len(others))
+			return "string", nullable
+		}
+		t, otherNullable := im.typeOf(others[0], hint, parent, where)
+		return t, nullable || otherNullable
+	}
+
+	if isJSONSchemaEnum(schema) {
+		values, _ := schema.get("enum")
+		list, _ := values.([]any)
+		nullable := false
+		for _, v := range list {
+			nullable = nullable || v == nil
+		}
+		if !hasJSONSchemaEnumValue(schema) {
+			t := im.enumFallbackType(schema, where)
+			im.notef("%s: the enum has no string, bool or integer value of 0 or more, using %s", where, t)
+			return t, nullable
+		}
+
+		name := im.objectName(hint, parent)
+		im.queue = append(im.queue, jsonSchemaDecl{name: name, schema: schema})
+		return name, nullable
+	}
+
+	types, nullable := jsonSchemaTypes(schema)
+	if len(types) > 1 {
+		im.unsupported(schema, where, "type")
+		im.notef("%s: values of types %s are not supported, using string", where, strings.Join(types, ", "))
+		return "string", nullable
+	}
+	if len(types) == 0 {
+		if _, ok := schema.get("properties"); ok {
+			types = []string{"object"}
+		} else if _, ok := schema.get("items"); ok {
+			types = []string{"array"}
+		} else if _, ok := schema.get("additionalProperties"); ok {
+			types = []string{"object"}
+		} else {
+			im.unsupported(schema, where)
+			im.notef("%s: any value is allowed, using string", where)
+			return "string", nullable
+		}
+	}
+
+	switch types[0] {
+	case "object":
+		if _, ok := schema.get("properties"); ok {
+			name := im.objectName(hint, parent)
+			im.queue = append(im.queue, jsonSchemaDecl{name: name, schema: schema})
+			return name, nullable
+		}
+
+		im.unsupported(schema, where, "type", "additionalProperties", "propertyNames")
+		key := im.mapKeyType(schema, where)
+		ap, ok := schema.get("additionalProperties")
+		if _, isSchema := ap.(jsonSchemaObject); !ok || !isSchema {
+			im.notef("%s: an object without properties or additionalProperties schema, using map %s for string", where, key)
+			return "map " + key + " for string", nullable
+		}
+		t, valueNullable := im.typeOf(ap, singular(hint), parent, where+"[]")
+		if valueNullable {
+			im.notef("%s: null values are not supported in a map", where)
+		}
+		return "map " + key + " for " + t, nullable
+	case "array":
+		im.unsupported(schema, where, "type", "items")
+		items, ok := schema.get("items")
+		if _, isSchema := items.(jsonSchemaObject); !ok || !isSchema {
+			im.notef("%s: an array without an items schema, using list of string", where)
+			return "list of string", nullable
+		}
+		t, itemNullable := im.typeOf(items, singular(hint), parent, where+"[]")
+		if itemNullable {
+			im.notef("%s: null items are not supported in a list", where)
+		}
+		return "list of " + t, nullable
+	case "null":
+		im.notef("%s: only null is allowed, using string", where)
+		return "string", true
+	default:
+		return im.primitiveType(schema, where), nullable
+	}
+}
+
+// enumFallbackType returns the primitive type of an enum schema without a value gidle enums can hold,
+// e.g. float64 for `{"enum": [0.5, 1.5]}`.
+func (im *jsonSchemaImporter) enumFallbackType(schema jsonSchemaObject, where string) string {
+	if _, ok := schema.get("type"); ok {
+		return im.primitiveType(schema.without("enum"), where)
+	}
+
+	im.unsupported(schema, where, "enum")
+	values, _ := schema.get("enum")
+	list, _ := values.([]any)
+	t := "string"
+	for _, v := range list {
+		if v, ok := v.(json.Number); ok {
+			if strings.ContainsAny(v.String(), ".eE") {
+				return "float64"
+			}
+			t = "int64"
+		}
+	}
+	return t
+}
+
+// primitiveType returns the type of a string, boolean, integer or number schema.
+func (im *jsonSchemaImporter) primitiveType(schema jsonSchemaObject, where string) string {
+	types, _ := jsonSchemaTypes(schema)
+	format, _ := schema.get("format")
+	switch {
+	case len(types) == 0 || types[0] == "string":
+		im.unsupported(schema, where, "type", "format")
+		return "string"
+	case types[0] == "boolean":
+		im.unsupported(schema, where, "type")
+		return "bool"
+	case types[0] == "number":
+		im.unsupported(schema, where, "type", "format")
+		if format == "float" {
+			return "float32"
+		}
+		return "float64"
+	}
+
+	im.unsupported(schema, where, "type", "format", "minimum", "maximum")
+	if format, ok := format.(string); ok {
+		if _, ok := map[string]bool{"int8": true, "int16": true, "int32": true, "int64": true, "uint8": true, "uint16": true, "uint32": true, "uint64": true}[format]; ok {
+			return format
+		}
+	}
+
+	// The narrowest integer type holding minimum and maximum.
+	min, hasMin := schema.get("minimum")
+	max, hasMax := schema.get("maximum")
+	if !hasMin || !hasMax {
+		return "int64"
+	}
+	bounds := &inferShape{}
+	for _, bound := range []any{min, max} {
+		if bound, ok := bound.(json.Number); ok {
+			bounds.addNumber(bound.String())
+		}
+	}
+	if bounds.float || bounds.numbers != 2 {
+		return "int64"
+	}
+	return numberType(bounds)
+}
+
+// mapKeyType returns the key type of a map from its propertyNames, as written by the JSON Schema generator.
+func (im *jsonSchemaImporter) mapKeyType(schema jsonSchemaObject, where string) string {
+	names, ok := schema.get("propertyNames")
+	if !ok {
+		return "string"
+	}
+
+	if names, ok := names.(jsonSchemaObject); ok {
+		if pattern, _ := names.get("pattern"); pattern != nil {
+			switch pattern {
+			case "^-?[0-9]+$":
+				return "int64"
+			case "^[0-9]+$":
+				return "uint64"
+			case "^-?[0-9]+(\\.[0-9]+)?([eE][-+]?[0-9]+)?$":
+				return "float64"
+			}
+		}
+		if values, _ := names.get("enum"); values != nil {
+			if list, ok := values.([]any); ok && len(list) == 2 && (list[0] == "true" && list[1] == "false" || list[0] == "false" && list[1] == "true") {
+				return "bool"
+			}
+		}
+	}
+
+	im.notef("%s: propertyNames is not supported and is dropped", where)
+	return "string"
+}
+
+// reference returns the type a `$ref` to a definition refers to.
+func (im *jsonSchemaImporter) reference(ref any, where string) string {
+	path, _ := ref.(string)
+	var key string
+	for _, prefix := range []string{"#/$defs/", "#/definitions/"} {
+		if strings.HasPrefix(path, prefix) {
+			key = strings.ReplaceAll(strings.ReplaceAll(strings.TrimPrefix(path, prefix), "~1", "/"), "~0", "~")
+		}
+	}
+
+	if name, ok := im.names[key]; ok {
+		return name
+	}
+
+	def, ok := im.defs.get(key)
+	if key == "" || !ok {
+		im.notef("%s: the reference %s is not a definition of the document, using string", where, path)
+		return "string"
+	}
+
+	// Definitions that are neither objects nor enums have no name in gidle and are written in place.
+	if im.resolving[key] {
+		im.notef("%s: the reference %s refers to itself, using string", where, path)
+		return "string"
+	}
+	im.resolving[key] = true
+	defer delete(im.resolving, key)
+
+	t, nullable := im.typeOf(def, key, "", where)
+	if nullable {
+		im.notef("%s: the definition %s allows null, which is only supported for fields", where, key)
+	}
+	return t
+}
+
+// declName returns a definition key as a declaration name, e.g. `OrderItem` for `order-item`.
+func (im *jsonSchemaImporter) declName(key string) string {
+	if isIdentifier(key) {
+		return strings.ToUpper(key[:1]) + key[1:]
+	}
+	if name := SnakeToPascal(fieldName(key)); name != "" && !(name[0] >= '0' && name[0] <= '9') {
+		return name
+	}
+
+	return "Def" + SnakeToPascal(fieldName(key))
+}
+
+// objectName returns an unused name for an object or enum declared inline in a property called hint.
+func (im *jsonSchemaImporter) objectName(hint string, parent string) string {
+	name := SnakeToPascal(hint)
+	if name == "" || im.used[name] {
+		name = parent + name
+	}
+	for base, n := name, 2; im.used[name]; n++ {
+		name = base + strconv.Itoa(n)
+	}
+	im.used[name] = true
+
+	return name
+}
+
+// without returns o without the member key.
+func (o jsonSchemaObject) without(key string) jsonSchemaObject {
+	members := make(jsonSchemaObject, 0, len(o))
+	for _, m := range o {
+		if m.Key != key {
+			members = append(members, m)
+		}
+	}
+
+	return members
+}
+
+func (o jsonSchemaObject) get(key string) (any, bool) {
+	for _, m := range o {
+		if m.Key == key {
+			return m.Value, true
+		}
+	}
+
+	return nil, false
+}
+
+// decodeJSONSchema reads the next value of decoder, with objects as jsonSchemaObject to keep their order.
+func decodeJSONSchema(decoder *json.Decoder) (any, error) {
+	token, err := decoder.Token()
+	if err != nil {
+		return nil, err
+	}
+
+	delim, ok := token.(json.Delim)
+	if !ok {
+		return token, nil
+	}
+
+	if delim == '[' {
+		list := []any{}
+		for decoder.More() {
+			value, err := decodeJSONSchema(decoder)
+			if err != nil {
+				return nil, err
+			}
+			list = append(list, value)
+		}
+		_, err := decoder.Token()
+		return list, err
+	}
+
+	object := jsonSchemaObject{}
+	for decoder.More() {
+		key, err := decoder.Token()
+		if err != nil {
+			return nil, err
+		}
+		value, err := decodeJSONSchema(decoder)
+		if err != nil {
+			return nil, err
+		}
+		object = append(object, jsonSchemaMember{key.(string), value})
+	}
+	_, err = decoder.Token()
+	return object, err
+}
+
+// jsonSchemaTypes returns the types of a schema's `type` other than null, and whether null is one of them.
+func jsonSchemaTypes(schema jsonSchemaObject) ([]string, bool) {
+	value, _ := schema.get("type")
+	var types []string
+	switch value := value.(type) {
+	case string:
+		types = []string{value}
+	case []any:
+		for _, t := range value {
+			if t, ok := t.(string); ok {
+				types = append(types, t)
+			}
+		}
+	}
+
+	nullable := false
+	others := types[:0]
+	for _, t := range types {
+		if t == "null" && len(types) > 1 {
+			nullable = true
+			continue
+		}
+		others = append(others, t)
+	}
+
+	return others, nullable
+}
+
+// isJSONSchemaDecl reports whether a definition becomes a declaration, an object or an enum.
+func isJSONSchemaDecl(schema jsonSchemaObject) bool {
+	_, properties := schema.get("properties")
+	return properties || isJSONSchemaEnum(schema)
+}
+
+func isJSONSchemaEnum(schema jsonSchemaObject) bool {
+	_, ok := schema.get("enum")
+	return ok
+}
+
+// hasJSONSchemaEnumValue reports whether an enum schema has a value a gidle enum can hold,
+// a string, a bool or an integer of 0 or more.
+func hasJSONSchemaEnumValue(schema jsonSchemaObject) bool {
+	values, _ := schema.get("enum")
+	list, _ := values.([]any)
+	for _, v := range list {
+		switch v := v.(type) {
+		case string, bool:
+			return true
+		case json.Number:
+			if _, err := strconv.ParseUint(v.String(), 10, 64); err == nil {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// writeJSONSchemaDoc writes the description of schema as doc comment lines.
+func writeJSONSchemaDoc(buffer *bytes.Buffer, indent string, schema jsonSchemaObject) {
+	description, _ := schema.get("description")
+	if description, ok := description.(string); ok && description != "" {
+		writeLineDoc(buffer, indent, "/// ", strings.Split(description, "\n"))
+	}
+}
+
+func isIdentifier(s string) bool {
+	if s == "" || s[0] >= '0' && s[0] <= '9' {
+		return false
+	}
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if !(c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9' || c == '_') {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,59 @@
+package gidle
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// TestImportJSONSchema converts every testdata/import/*.schema.json document and compares the
+// schema with <document>.golden and the notes with <document>.notes.golden.
+func TestImportJSONSchema(t *testing.T) {
+	documents, err := filepath.Glob(filepath.Join("testdata", "import", "*.schema.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, document := range documents {
+		name := strings.TrimSuffix(filepath.Base(document), ".schema.json")
+		t.Run(name, func(t *testing.T) {
+			data, err := os.ReadFile(document)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			schema, notes, err := ImportJSONSchema(data, "imported", SnakeToPascal(name))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if _, err := Parse(bytes.NewReader(schema)); err != nil {
+				t.Fatalf("the converted schema does not check: %v\n%s", err, schema)
+			}
+
+			root := filepath.Dir(document)
+			got := map[string]string{
+				name + ".golden":       string(schema),
+				name + ".notes.golden": strings.Join(notes, "\n") + "\n",
+			}
+			for file, data := range got {
+				golden := filepath.Join(root, file)
+				if *update {
+					if err := os.WriteFile(golden, []byte(data), 0644); err != nil {
+						t.Fatal(err)
+					}
+					continue
+				}
+
+				want, err := os.ReadFile(golden)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if data != string(want) {
+					t.Errorf("%s differs:\n%s", golden, diffLines(string(want), data))
+				}
+			}
+		})
+	}
+}
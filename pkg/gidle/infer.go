@@ -0,0 +1,481 @@
+package gidle
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// inferShape is what was seen at one place of the samples, merged over every value found there.
+type inferShape struct {
+	null    bool
+	boolean bool
+	str     bool
+
+	integer  bool
+	min      int64
+	max      uint64
+	float    bool
+	float64  bool
+	numbers  int
+	arrays   int
+	elements *inferShape
+
+	objects int
+	fields  []*inferField
+	keys    map[string]*inferField
+}
+
+type inferField struct {
+	key   string
+	count int
+	shape *inferShape
+}
+
+// Inferrer infers a schema from sample JSON documents.
+type Inferrer struct {
+	name string
+	root *inferShape
+}
+
+// NewInferrer returns an Inferrer of an object called name.
+func NewInferrer(name string) *Inferrer {
+	return &Inferrer{
+		name: name,
+		root: &inferShape{},
+	}
+}
+
+// Add merges a sample into the inferred object. A sample is a JSON object, or an
+// array of JSON objects that are each added as a sample.
+func (i *Inferrer) Add(data []byte) error {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	sample := &inferShape{}
+	if err := inferValue(decoder, sample); err != nil {
+		return fmt.Errorf("invalid JSON: %w", err)
+	}
+	if _, err := decoder.Token(); err != io.EOF {
+		return errors.New("invalid JSON: more than one value")
+	}
+
+	switch {
+	case sample.objects == 1:
+	case sample.arrays == 1 && sample.elements.objects > 0 && kinds(sample.elements) == 1:
+		sample = sample.elements
+	default:
+		return errors.New("a sample must be an object or an array of objects")
+	}
+
+	mergeShape(i.root, sample)
+	return nil
+}
+
+// Schema returns the inferred schema as a formatted .gidle file of package pkg, declaring the object
+// and every nested object, with a note for each field missing in some samples and each value that
+// could not be represented exactly.
+//
+// Numbers get the narrowest type holding every sample value, e.g. uint8 for 0 to 255, fields missing
+// or null in some samples are optional, and objects whose keys are all integers or are not names are
+// maps. Nested objects are named after their field, singular for lists and maps.
+func (i *Inferrer) Schema(pkg string) ([]byte, []string) {
+	s := &schemaWriter{
+		root: i.root,
+		used: map[string]bool{i.name: true},
+	}
+	s.queue = append(s.queue, inferObject{name: i.name, shape: i.root})
+
+	var buffer bytes.Buffer
+	buffer.WriteString("package " + pkg + "\n")
+	for len(s.queue) > 0 {
+		object := s.queue[0]
+		s.queue = s.queue[1:]
+		buffer.WriteString("\n")
+		s.writeObject(&buffer, object)
+	}
+
+	formatted, err := Format(pkg+".gidle", buffer.Bytes())
+	if err != nil {
+		// Unreachable unless the writer is wrong, keep the unformatted schema to show what it wrote.
+		s.notes = append(s.notes, "the inferred schema does not parse: "+err.Error())
+		return buffer.Bytes(), s.notes
+	}
+
+	return formatted, s.notes
+}
+
+// inferValue reads the next value of decoder into shape.
+func inferValue(decoder *json.Decoder, shape *inferShape) error {
+	token, err := decoder.Token()
+	if err != nil {
+		return err
+	}
+
+	switch token := token.(type) {
+	case nil:
+		shape.null = true
+	case bool:
+		shape.boolean = true
+	case string:
+		shape.str = true
+	case json.Number:
+		shape.addNumber(token.String())
+	case json.Delim:
+		if token == '[' {
+			shape.arrays++
+			if shape.elements == nil {
+				shape.elements = &inferShape{}
+			}
+			for decoder.More() {
+				if err := inferValue(decoder, shape.elements); err != nil {
+					return err
+				}
+			}
+		} else {
+			shape.objects++
+			seen := make(map[string]bool)
+			for decoder.More() {
+				token, err := decoder.Token()
+				if err != nil {
+					return err
+				}
+				key := token.(string)
+				field := shape.field(key)
+				if !seen[key] {
+					seen[key] = true
+					field.count++
+				}
+				if err := inferValue(decoder, field.shape); err != nil {
+					return err
+				}
+			}
+		}
+		// The closing delimiter.
+		if _, err := decoder.Token(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (s *inferShape) field(key string) *inferField {
+	if s.keys == nil {
+		s.keys = make(map[string]*inferField)
+	}
+	field, ok := s.keys[key]
+	if !ok {
+		field = &inferField{key: key, shape: &inferShape{}}
+		s.keys[key] = field
+		s.fields = append(s.fields, field)
+	}
+
+	return field
+}
+
+func (s *inferShape) addNumber(number string) {
+	s.numbers++
+	if strings.HasPrefix(number, "-") {
+		if n, err := strconv.ParseInt(number, 10, 64); err == nil {
+			s.addInteger(n, 0)
+			s.addFloat(float64(n))
+			return
+		}
+	} else if n, err := strconv.ParseUint(number, 10, 64); err == nil {
+		s.addInteger(0, n)
+		s.addFloat(float64(n))
+		return
+	}
+
+	s.float = true
+	f, err := strconv.ParseFloat(number, 64)
+	if err != nil {
+		s.float64 = true
+		return
+	}
+	s.addFloat(f)
+}
+
+func (s *inferShape) addInteger(min int64, max uint64) {
+	if !s.integer || min < s.min {
+		s.min = min
+	}
+	if !s.integer || max > s.max {
+		s.max = max
+	}
+	s.integer = true
+}
+
+// addFloat records whether every number so far is exact as a float32.
+func (s *inferShape) addFloat(f float64) {
+	if float64(float32(f)) != f {
+		s.float64 = true
+	}
+}
+
+// mergeShape merges src, seen at another place or in another sample, into dst.
+func mergeShape(dst, src *inferShape) {
+	dst.null = dst.null || src.null
+	dst.boolean = dst.boolean || src.boolean
+	dst.str = dst.str || src.str
+
+	if src.integer {
+		dst.addInteger(src.min, src.max)
+	}
+	dst.float = dst.float || src.float
+	dst.float64 = dst.float64 || src.float64
+	dst.numbers += src.numbers
+
+	if src.arrays > 0 {
+		dst.arrays += src.arrays
+		if dst.elements == nil {
+			dst.elements = &inferShape{}
+		}
+		mergeShape(dst.elements, src.elements)
+	}
+
+	dst.objects += src.objects
+	for _, f := range src.fields {
+		field := dst.field(f.key)
+		field.count += f.count
+		mergeShape(field.shape, f.shape)
+	}
+}
+
+// kinds returns the number of JSON types other than null that were seen.
+func kinds(s *inferShape) int {
+	n := 0
+	for _, seen := range []bool{s.boolean, s.str, s.numbers > 0, s.arrays > 0, s.objects > 0} {
+		if seen {
+			n++
+		}
+	}
+
+	return n
+}
+
+type inferObject struct {
+	name  string
+	shape *inferShape
+}
+
+type schemaWriter struct {
+	root  *inferShape
+	used  map[string]bool
+	queue []inferObject
+	notes []string
+}
+
+func (s *schemaWriter) writeObject(buffer *bytes.Buffer, object inferObject) {
+	buffer.WriteString("object " + object.name + " {\n")
+
+	names := make(map[string]bool)
+	for _, f := range object.shape.fields {
+		name := fieldName(f.key)
+		if name == "" || name[0] >= '0' && name[0] <= '9' {
+			name = "field_" + name
+		}
+		for names[name] {
+			name += "_"
+		}
+		names[name] = true
+
+		where := object.name + "." + name
+		t := s.typeOf(f.shape, name, object.name, where)
+
+		buffer.WriteString(FormatIndent)
+		if name != f.key {
+			buffer.WriteString("@json(" + strconv.Quote(f.key) + ") ")
+		}
+		if f.count < object.shape.objects || f.shape.null {
+			buffer.WriteString("optional ")
+		}
+		buffer.WriteString(t + " " + name + "\n")
+
+		if f.count < object.shape.objects {
+			if object.shape == s.root {
+				s.notef("field %s is missing in %d of %d samples", where, object.shape.objects-f.count, object.shape.objects)
+			} else {
+				s.notef("field %s is missing in %d of %d %s objects", where, object.shape.objects-f.count, object.shape.objects, object.name)
+			}
+		}
+	}
+
+	buffer.WriteString("}\n")
+}
+
+// typeOf returns the type of the values of shape, declaring a new object named after hint
+// in parent for JSON objects. where names the place of the values in notes.
+func (s *schemaWriter) typeOf(shape *inferShape, hint string, parent string, where string) string {
+	if kinds(shape) > 1 {
+		s.notef("%s has values of different JSON types, using string", where)
+		return "string"
+	}
+
+	switch {
+	case shape.boolean:
+		return "bool"
+	case shape.str:
+		return "string"
+	case shape.numbers > 0:
+		t := numberType(shape)
+		if t == "float64" && !shape.float {
+			s.notef("%s has integers from %d to %d, which no integer type holds, using float64", where, shape.min, shape.max)
+		}
+		return t
+	case shape.arrays > 0:
+		if kinds(shape.elements) == 0 {
+			if shape.elements.null {
+				s.notef("%s only has null elements, using list of string", where)
+			} else {
+				s.notef("%s is always an empty list, using list of string", where)
+			}
+			return "list of string"
+		}
+		if shape.elements.null {
+			s.notef("%s has null elements, which a list cannot hold", where)
+		}
+		return "list of " + s.typeOf(shape.elements, singular(hint), parent, where+"[]")
+	case shape.objects > 0:
+		if key, ok := mapKeyType(shape); ok {
+			values := &inferShape{}
+			for _, f := range shape.fields {
+				mergeShape(values, f.shape)
+			}
+			if kinds(values) == 0 {
+				s.notef("%s is always an empty object, using map string for string", where)
+				return "map string for string"
+			}
+			if values.null {
+				s.notef("%s has null values, which a map cannot hold", where)
+			}
+			return "map " + key + " for " + s.typeOf(values, singular(hint), parent, where+"[]")
+		}
+		name := s.objectName(hint, parent)
+		s.queue = append(s.queue, inferObject{name: name, shape: shape})
+		return name
+	default:
+		s.notef("%s is always null, using string", where)
+		return "string"
+	}
+}
+
+func (s *schemaWriter) objectName(hint string, parent string) string {
+	name := SnakeToPascal(hint)
+	if name == "" || s.used[name] {
+		name = parent + name
+	}
+	for n := 2; s.used[name]; n++ {
+		name = strings.TrimRight(name, "0123456789") + strconv.Itoa(n)
+	}
+	s.used[name] = true
+
+	return name
+}
+
+func (s *schemaWriter) notef(format string, args ...any) {
+	s.notes = append(s.notes, fmt.Sprintf(format, args...))
+}
+
+// numberType returns the narrowest type holding every number of shape.
+func numberType(shape *inferShape) string {
+	if shape.float {
+		if shape.float64 {
+			return "float64"
+		}
+		return "float32"
+	}
+
+	if shape.min < 0 {
+		for _, t := range []string{"int8", "int16", "int32", "int64"} {
+			if min, max := integerRange(t); shape.min >= min && shape.max <= max {
+				return t
+			}
+		}
+		return "float64"
+	}
+
+	for _, t := range []string{"uint8", "uint16", "uint32", "uint64"} {
+		if _, max := integerRange(t); shape.max <= max {
+			return t
+		}
+	}
+	return "uint64"
+}
+
+// mapKeyType returns the map key type of a JSON object whose keys are all integers, or are not
+// all field names, and false for objects. Integer keys no integer type holds are strings.
+func mapKeyType(shape *inferShape) (string, bool) {
+	if len(shape.fields) == 0 {
+		return "string", true
+	}
+
+	keys := &inferShape{}
+	integers := true
+	names := true
+	for _, f := range shape.fields {
+		_, intErr := strconv.ParseInt(f.key, 10, 64)
+		_, uintErr := strconv.ParseUint(f.key, 10, 64)
+		if intErr == nil || uintErr == nil {
+			keys.addNumber(f.key)
+		} else {
+			integers = false
+		}
+		if name := fieldName(f.key); name == "" || name[0] >= '0' && name[0] <= '9' {
+			names = false
+		}
+	}
+
+	switch {
+	case integers && numberType(keys) != "float64":
+		return numberType(keys), true
+	case !names:
+		return "string", true
+	default:
+		return "", false
+	}
+}
+
+// fieldName returns key as a snake_case field name, e.g. `user_id` for `userId` or `user-id`.
+// It is empty when key has no letters or digits.
+func fieldName(key string) string {
+	var sb strings.Builder
+	for i, r := range key {
+		switch {
+		case r >= 'A' && r <= 'Z':
+			if i > 0 && sb.Len() > 0 && !strings.HasSuffix(sb.String(), "_") {
+				prev := key[i-1]
+				if prev >= 'a' && prev <= 'z' || prev >= '0' && prev <= '9' {
+					sb.WriteByte('_')
+				}
+			}
+			sb.WriteRune(r + 'a' - 'A')
+		case r >= 'a' && r <= 'z' || r >= '0' && r <= '9':
+			sb.WriteRune(r)
+		default:
+			if sb.Len() > 0 && !strings.HasSuffix(sb.String(), "_") {
+				sb.WriteByte('_')
+			}
+		}
+	}
+
+	return strings.TrimSuffix(sb.String(), "_")
+}
+
+// singular returns the singular of an English plural noun, or name unchanged.
+func singular(name string) string {
+	switch {
+	case strings.HasSuffix(name, "ies") && len(name) > 3:
+		return name[:len(name)-3] + "y"
+	case strings.HasSuffix(name, "sses"), strings.HasSuffix(name, "xes"), strings.HasSuffix(name, "ches"), strings.HasSuffix(name, "shes"):
+		return name[:len(name)-2]
+	case strings.HasSuffix(name, "s") && !strings.HasSuffix(name, "ss") && len(name) > 1:
+		return name[:len(name)-1]
+	default:
+		return name
+	}
+}
@@ -0,0 +1,65 @@
+package gidle
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// TestInfer infers the Person object from every testdata/infer/*.json sample and compares the
+// schema with testdata/infer/person.golden and the notes with testdata/infer/notes.golden.
+func TestInfer(t *testing.T) {
+	root := filepath.Join("testdata", "infer")
+	samples, err := filepath.Glob(filepath.Join(root, "*.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	inferrer := NewInferrer("Person")
+	for _, sample := range samples {
+		data, err := os.ReadFile(sample)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := inferrer.Add(data); err != nil {
+			t.Fatalf("%s: %v", sample, err)
+		}
+	}
+
+	schema, notes := inferrer.Schema("people")
+	if _, err := Parse(bytes.NewReader(schema)); err != nil {
+		t.Fatalf("the inferred schema does not check: %v\n%s", err, schema)
+	}
+
+	got := map[string]string{
+		"person.golden": string(schema),
+		"notes.golden":  strings.Join(notes, "\n") + "\n",
+	}
+	for name, data := range got {
+		golden := filepath.Join(root, name)
+		if *update {
+			if err := os.WriteFile(golden, []byte(data), 0644); err != nil {
+				t.Fatal(err)
+			}
+			continue
+		}
+
+		want, err := os.ReadFile(golden)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if data != string(want) {
+			t.Errorf("%s differs:\n%s", golden, diffLines(string(want), data))
+		}
+	}
+}
+
+func TestInferInvalidSample(t *testing.T) {
+	for _, sample := range []string{`"text"`, `[1, 2]`, `{"a": 1} {"a": 2}`, `{"a": `} {
+		if err := NewInferrer("Person").Add([]byte(sample)); err == nil {
+			t.Errorf("%s: expected an error", sample)
+		}
+	}
+}
@@ -0,0 +1,141 @@
+package gidle
+
+import (
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/alecthomas/participle/v2"
+)
+
+// Loader parses a schema and every schema it imports, resolving import paths
+// relative to the importing file. Each file is parsed once.
+type Loader struct {
+	parser  *participle.Parser[Grammar]
+	loaded  map[string]*Grammar
+	loading []string
+	order   []*Grammar
+	sources map[string][]byte
+}
+
+func NewLoader() *Loader {
+	return &Loader{
+		parser:  NewParser(),
+		loaded:  make(map[string]*Grammar),
+		sources: make(map[string][]byte),
+	}
+}
+
+// Load parses path and its imports. Missing imports and import cycles are reported as CheckErrors.
+func (l *Loader) Load(path string) (*Grammar, error) {
+	return l.load(filepath.Clean(path))
+}
+
+// Grammars returns every loaded schema, imported schemas before the schemas importing them.
+func (l *Loader) Grammars() []*Grammar {
+	return l.order
+}
+
+// Sources returns the contents of every file read by the loader by path.
+func (l *Loader) Sources() map[string][]byte {
+	return l.sources
+}
+
+func (l *Loader) load(path string) (*Grammar, error) {
+	if values, ok := l.loaded[path]; ok {
+		return values, nil
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	l.sources[path] = data
+
+	values, err := l.parser.ParseBytes(path, data)
+	if err != nil {
+		return nil, err
+	}
+	values.Path = path
+
+	l.loading = append(l.loading, path)
+	defer func() {
+		l.loading = l.loading[:len(l.loading)-1]
+	}()
+
+	var errs CheckErrors
+	for i := range values.Imports {
+		imp := &values.Imports[i]
+		importPath := filepath.Join(filepath.Dir(path), filepath.FromSlash(imp.Path))
+
+		if cycle := l.cycle(importPath); cycle != nil {
+			errs = append(errs, &CheckError{Pos: imp.Pos, Message: "import cycle: " + strings.Join(cycle, " -> ")})
+			continue
+		}
+
+		imported, err := l.load(importPath)
+		if errors.Is(err, fs.ErrNotExist) {
+			errs = append(errs, &CheckError{Pos: imp.Pos, Message: "cannot import " + imp.Path + ": file does not exist"})
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		imp.Grammar = imported
+	}
+	if len(errs) > 0 {
+		return nil, errs
+	}
+
+	l.loaded[path] = values
+	l.order = append(l.order, values)
+
+	return values, nil
+}
+
+// cycle returns the import chain leading back to path if path is being loaded.
+func (l *Loader) cycle(path string) []string {
+	for i, p := range l.loading {
+		if p == path {
+			return append(append([]string{}, l.loading[i:]...), path)
+		}
+	}
+
+	return nil
+}
+
+// LookupEntry resolves a type reference made in values, following imports for
+// qualified references. It returns nil when nothing is declared under that name.
+func LookupEntry(values *Grammar, identity string) (*Grammar, *Entry) {
+	qualifier, name := SplitIdentity(identity)
+	if qualifier != "" {
+		imp := LookupImport(values, qualifier)
+		if imp == nil || imp.Grammar == nil {
+			return nil, nil
+		}
+		values = imp.Grammar
+	}
+
+	for i, entry := range values.Entries {
+		if entry.Const != nil && entry.Const.Name == name ||
+			entry.Enum != nil && entry.Enum.Name == name ||
+			entry.Object != nil && entry.Object.Name == name {
+			return values, &values.Entries[i]
+		}
+	}
+
+	return nil, nil
+}
+
+// LookupImport returns the import of values referred to as qualifier.
+func LookupImport(values *Grammar, qualifier string) *Import {
+	for i := range values.Imports {
+		if ImportQualifier(&values.Imports[i]) == qualifier {
+			return &values.Imports[i]
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,99 @@
+package gidle
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestLoader(t *testing.T) {
+	tests := []struct {
+		name  string
+		files map[string]string
+		// want is the error of loading main.gidle, with the test directory removed from paths.
+		want string
+		// order is the package of every loaded schema when loading succeeds.
+		order []string
+	}{
+		{
+			name: "shared import",
+			files: map[string]string{
+				"main.gidle":         "package app\nimport \"a.gidle\"\nimport \"common/types.gidle\"\n",
+				"a.gidle":            "package a\nimport \"common/types.gidle\"\n",
+				"common/types.gidle": "package common\n",
+			},
+			order: []string{"common", "a", "app"},
+		},
+		{
+			name: "missing import",
+			files: map[string]string{
+				"main.gidle": "package app\nimport \"a.gidle\"\nimport \"missing.gidle\"\n",
+				"a.gidle":    "package a\n",
+			},
+			want: "main.gidle:3:1: cannot import missing.gidle: file does not exist",
+		},
+		{
+			name: "missing nested import",
+			files: map[string]string{
+				"main.gidle":  "package app\nimport \"sub/a.gidle\"\n",
+				"sub/a.gidle": "package a\nimport \"b.gidle\"\n",
+			},
+			want: "sub/a.gidle:2:1: cannot import b.gidle: file does not exist",
+		},
+		{
+			name: "self import",
+			files: map[string]string{
+				"main.gidle": "package app\nimport \"main.gidle\"\n",
+			},
+			want: "main.gidle:2:1: import cycle: main.gidle -> main.gidle",
+		},
+		{
+			name: "import cycle",
+			files: map[string]string{
+				"main.gidle":  "package app\nimport \"sub/a.gidle\"\n",
+				"sub/a.gidle": "package a\nimport \"../b.gidle\"\n",
+				"b.gidle":     "package b\nimport \"sub/a.gidle\"\n",
+			},
+			want: "b.gidle:2:1: import cycle: sub/a.gidle -> b.gidle -> sub/a.gidle",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			dir := t.TempDir()
+			for name, source := range test.files {
+				path := filepath.Join(dir, filepath.FromSlash(name))
+				if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+					t.Fatal(err)
+				}
+				if err := os.WriteFile(path, []byte(source), 0644); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			loader := NewLoader()
+			_, err := loader.Load(filepath.Join(dir, "main.gidle"))
+			if test.want != "" {
+				if err == nil {
+					t.Fatalf("Load succeeded, want %s", test.want)
+				}
+				if got := filepath.ToSlash(strings.ReplaceAll(err.Error(), dir+string(filepath.Separator), "")); got != test.want {
+					t.Errorf("Load error is %s, want %s", got, test.want)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var order []string
+			for _, values := range loader.Grammars() {
+				order = append(order, strings.Join(values.Package.Names, "."))
+			}
+			if strings.Join(order, " ") != strings.Join(test.order, " ") {
+				t.Errorf("loaded %v, want %v", order, test.order)
+			}
+		})
+	}
+}
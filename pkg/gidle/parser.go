@@ -0,0 +1,278 @@
+package gidle
+
+import (
+	"io"
+	"strings"
+
+	"github.com/alecthomas/participle/v2"
+	"github.com/alecthomas/participle/v2/lexer"
+)
+
+// gidleRules skip whitespace, `//` line comments and `/* */` block comments, but keep `///`
+// followed by text or the end of the line as a doc comment. Banners like `//////` are comments.
+var gidleRules = lexer.MustSimple([]lexer.SimpleRule{
+	{Name: "DocComment", Pattern: `///([^/\n][^\n]*|(?m:$))`},
+	{Name: "Comment", Pattern: `//[^\n]*|/\*([^*]|\*+[^*/])*\*+/`},
+	{Name: "String", Pattern: `"(\\.|[^"\\\n])*"`},
+	{Name: "Float", Pattern: `[0-9]+\.[0-9]+([eE][-+]?[0-9]+)?|[0-9]+[eE][-+]?[0-9]+`},
+	{Name: "Int", Pattern: `0[xX][0-9a-fA-F]+|[0-9]+`},
+	{Name: "Ident", Pattern: `[a-zA-Z_][a-zA-Z0-9_]*`},
+	{Name: "Punct", Pattern: `[.,?@(){}\[\]=]`},
+	{Name: "Whitespace", Pattern: `\s+`},
+})
+
+// gidleLexer lexes with gidleRules, but only keeps doc comments that start their line and document
+// a declaration or member, i.e. are followed by a name, a keyword other than `package` and `import`
+// or an attribute. Other doc comments, e.g. after the last field or at the end of a line, become
+// comments and are elided.
+var gidleLexer = docLexer{gidleRules}
+
+type docLexer struct {
+	rules *lexer.StatefulDefinition
+}
+
+func (d docLexer) Symbols() map[string]lexer.TokenType {
+	return d.rules.Symbols()
+}
+
+func (d docLexer) Lex(filename string, r io.Reader) (lexer.Lexer, error) {
+	lex, err := d.rules.Lex(filename, r)
+	if err != nil {
+		return nil, err
+	}
+
+	return d.relabel(lex)
+}
+
+func (d docLexer) LexString(filename string, input string) (lexer.Lexer, error) {
+	lex, err := d.rules.LexString(filename, input)
+	if err != nil {
+		return nil, err
+	}
+
+	return d.relabel(lex)
+}
+
+// relabel turns the doc comments that document nothing into comments.
+func (d docLexer) relabel(lex lexer.Lexer) (lexer.Lexer, error) {
+	tokens, err := lexer.ConsumeAll(lex)
+	if err != nil {
+		return nil, err
+	}
+
+	symbols := d.rules.Symbols()
+	doc, comment, whitespace := symbols["DocComment"], symbols["Comment"], symbols["Whitespace"]
+	for i := range tokens {
+		if tokens[i].Type != doc {
+			continue
+		}
+
+		lineStart := i == 0 || tokens[i-1].Type == whitespace && strings.Contains(tokens[i-1].Value, "\n")
+		next := i + 1
+		for tokens[next].Type == doc || tokens[next].Type == comment || tokens[next].Type == whitespace {
+			next++
+		}
+		documents := tokens[next].Type == symbols["Ident"] && tokens[next].Value != "package" && tokens[next].Value != "import" ||
+			tokens[next].Value == "@"
+		if !lineStart || !documents {
+			tokens[i].Type = comment
+		}
+	}
+
+	return &tokenLexer{tokens: tokens}, nil
+}
+
+// tokenLexer returns tokens that were already lexed.
+type tokenLexer struct {
+	tokens []lexer.Token
+}
+
+func (l *tokenLexer) Next() (lexer.Token, error) {
+	token := l.tokens[0]
+	if len(l.tokens) > 1 {
+		l.tokens = l.tokens[1:]
+	}
+
+	return token, nil
+}
+
+// Doc is the text of the `///` comments before a declaration, one entry per line.
+type Doc []string
+
+func (d *Doc) Capture(values []string) error {
+	for _, v := range values {
+		line := strings.TrimPrefix(v, "///")
+		line = strings.TrimPrefix(line, " ")
+		*d = append(*d, strings.TrimRight(line, " \t\r"))
+	}
+
+	return nil
+}
+
+type ListType struct {
+	ElementType Type `"list" "of" @@`
+}
+
+type ListValue struct {
+	Values []Value `"[" (@@ ("," @@)*)? "]"`
+}
+
+type MapType struct {
+	KeyType   PrimitiveType `"map" @@`
+	ValueType Type          `"for" @@`
+}
+
+type MapEntry struct {
+	Key   Value `@@`
+	Value Value `"of" @@`
+}
+
+type MapValue struct {
+	Entries []MapEntry `"{" @@* "}"`
+}
+
+type PrimitiveType struct {
+	Type string `@("int8"|"int16"|"int32"|"int64"|"uint8"|"uint16"|"uint32"|"uint64"|"float32"|"float64"|"string"|"bool")`
+}
+
+// Boolean captures "true" and "false"; participle sets a plain bool to true on any match.
+type Boolean bool
+
+func (b *Boolean) Capture(values []string) error {
+	*b = values[0] == "true"
+	return nil
+}
+
+type PrimitiveValue struct {
+	Pos    lexer.Position
+	EndPos lexer.Position
+
+	IntValue    *int64   `@Int`
+	FloatValue  *float64 `| @Float`
+	StringValue *string  `| @String`
+	BoolValue   *Boolean `| @("true"|"false")`
+}
+
+type Value struct {
+	PrimitiveValue *PrimitiveValue `@@`
+	ListValue      *ListValue      `| @@`
+	MapValue       *MapValue       `| @@`
+}
+
+type Type struct {
+	Pos lexer.Position
+
+	PrimitiveType *PrimitiveType `@@`
+	ListType      *ListType      `| @@`
+	MapType       *MapType       `| @@`
+	Identity      *string        `| @(Ident ("." Ident)?)`
+}
+
+// Attribute annotates an object field for generators, e.g. `@proto(3)`.
+type Attribute struct {
+	Pos lexer.Position
+
+	Name  string          `"@" @Ident`
+	Value *PrimitiveValue `("(" @@ ")")?`
+}
+
+type ObjectField struct {
+	Pos    lexer.Position
+	EndPos lexer.Position
+
+	Doc        Doc         `@DocComment*`
+	Attributes []Attribute `@@*`
+	Optional   bool        `@"optional"?`
+	Type       Type        `@@`
+	Nullable   bool        `@"?"?`
+	Name       string      `@Ident`
+}
+
+type Object struct {
+	Pos    lexer.Position
+	EndPos lexer.Position
+
+	Doc    Doc           `@DocComment*`
+	Name   string        `"object" @Ident`
+	Fields []ObjectField `"{" @@* "}"`
+}
+
+type EnumValue struct {
+	Pos    lexer.Position
+	EndPos lexer.Position
+
+	Doc   Doc            `@DocComment*`
+	Name  string         `@Ident`
+	Value PrimitiveValue `"=" @@`
+}
+
+type Enum struct {
+	Pos    lexer.Position
+	EndPos lexer.Position
+
+	Doc  Doc           `@DocComment*`
+	Name string        `"enum" @Ident`
+	Type PrimitiveType `"for" @@`
+	Body []EnumValue   `"{" @@* "}"`
+}
+
+type ConstField struct {
+	Pos    lexer.Position
+	EndPos lexer.Position
+
+	Doc   Doc            `@DocComment*`
+	Name  string         `@Ident`
+	Value PrimitiveValue `"=" @@`
+}
+
+type Const struct {
+	Pos    lexer.Position
+	EndPos lexer.Position
+
+	Doc    Doc           `@DocComment*`
+	Name   string        `"const" @Ident`
+	Type   PrimitiveType `"for" @@`
+	Fields []ConstField  `"{" @@* "}"`
+}
+
+type Package struct {
+	Pos    lexer.Position
+	EndPos lexer.Position
+
+	Names []string `"package" @Ident ("." @Ident)*`
+}
+
+type Import struct {
+	Pos    lexer.Position
+	EndPos lexer.Position
+
+	Path  string  `"import" @String`
+	Alias *string `("as" @Ident)?`
+
+	// Grammar is the imported schema. It is set by the Loader.
+	Grammar *Grammar
+}
+
+type Entry struct {
+	Const  *Const  `@@`
+	Enum   *Enum   `| @@`
+	Object *Object `| @@`
+}
+
+type Grammar struct {
+	Package Package  `@@`
+	Imports []Import `@@*`
+	Entries []Entry  `@@*`
+
+	// Path is the file the schema was loaded from. It is set by the Loader.
+	Path string
+}
+
+func NewParser() *participle.Parser[Grammar] {
+	return participle.MustBuild[Grammar](
+		participle.Lexer(gidleLexer),
+		participle.Elide("Comment", "Whitespace"),
+		participle.Unquote("String"),
+		participle.UseLookahead(participle.MaxLookahead),
+	)
+}
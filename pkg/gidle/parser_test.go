@@ -0,0 +1,136 @@
+package gidle
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestParseDocComments(t *testing.T) {
+	tests := []struct {
+		name   string
+		schema string
+		// want is the doc of every documented declaration and member, as `<name>: <lines>`.
+		want []string
+	}{
+		{
+			name: "declarations and members",
+			schema: `package acme
+/// A kind.
+enum Kind for uint8 {
+    /// The first.
+    A = 0
+}
+/// Limits.
+/// In two lines.
+const Limits for int32 {
+    ///
+    /// Most items.
+    MAX = 10
+}
+/// A thing.
+object Thing {
+    /// Its name.
+    @json("n") string name
+    /// Maybe a count.
+    optional int32 count
+}`,
+			want: []string{
+				"Kind: A kind.",
+				"Kind.A: The first.",
+				"Limits: Limits.|In two lines.",
+				"Limits.MAX: |Most items.",
+				"Thing: A thing.",
+				"Thing.name: Its name.",
+				"Thing.count: Maybe a count.",
+			},
+		},
+		{
+			name: "before package and imports",
+			schema: `/// header
+package acme
+/// about the import
+import "x.gidle"
+object Thing {
+    string name
+}`,
+		},
+		{
+			name: "after the last member",
+			schema: `package acme
+object Thing {
+    string name
+    /// dangling
+}
+enum Kind for uint8 {
+    A = 0
+    /// dangling
+}`,
+		},
+		{
+			name: "at the end of the file",
+			schema: `package acme
+object Thing {
+    string name
+}
+/// trailing`,
+		},
+		{
+			name: "at the end of a line",
+			schema: `package acme
+object Thing {
+    string name /// note
+    int32 count
+}`,
+		},
+		{
+			name: "banners",
+			schema: `package acme
+//////////////
+/// A thing.
+object Thing {
+    ////
+    string name
+}`,
+			want: []string{"Thing: A thing."},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			values, err := NewParser().ParseString("test.gidle", test.schema)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var got []string
+			add := func(name string, doc Doc) {
+				if len(doc) > 0 {
+					got = append(got, name+": "+strings.Join(doc, "|"))
+				}
+			}
+			for _, entry := range values.Entries {
+				switch {
+				case entry.Const != nil:
+					add(entry.Const.Name, entry.Const.Doc)
+					for _, f := range entry.Const.Fields {
+						add(entry.Const.Name+"."+f.Name, f.Doc)
+					}
+				case entry.Enum != nil:
+					add(entry.Enum.Name, entry.Enum.Doc)
+					for _, v := range entry.Enum.Body {
+						add(entry.Enum.Name+"."+v.Name, v.Doc)
+					}
+				case entry.Object != nil:
+					add(entry.Object.Name, entry.Object.Doc)
+					for _, f := range entry.Object.Fields {
+						add(entry.Object.Name+"."+f.Name, f.Doc)
+					}
+				}
+			}
+
+			if strings.Join(got, "\n") != strings.Join(test.want, "\n") {
+				t.Errorf("docs differ:\n%s", diffLines(strings.Join(test.want, "\n"), strings.Join(got, "\n")))
+			}
+		})
+	}
+}
@@ -1,4 +1,4 @@
-package main
+package gidle
 
 import "strings"
 
@@ -6,7 +6,7 @@ func SnakeToPascal(s string) string {
 	sb := strings.Builder{}
 	sb.Grow(len(s))
 
-	for i := range s {
+	for i := 0; i < len(s); i++ {
 		if i == 0 && s[i] >= 'a' && s[i] <= 'z' {
 			sb.WriteByte(s[i] - 32)
 		} else if s[i] == '_' && i+1 < len(s) && s[i+1] >= 'a' && s[i+1] <= 'z' {
@@ -24,7 +24,7 @@ func SnakeToCamel(s string) string {
 	sb := strings.Builder{}
 	sb.Grow(len(s))
 
-	for i := range s {
+	for i := 0; i < len(s); i++ {
 		if s[i] == '_' && i+1 < len(s) && s[i+1] >= 'a' && s[i+1] <= 'z' {
 			sb.WriteByte(s[i+1] - 32)
 			i++
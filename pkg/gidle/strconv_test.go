@@ -0,0 +1,28 @@
+package gidle
+
+import "testing"
+
+func TestSnakeCase(t *testing.T) {
+	tests := []struct {
+		snake  string
+		pascal string
+		camel  string
+	}{
+		{"id", "Id", "id"},
+		{"user_id", "UserId", "userId"},
+		{"first_name_long", "FirstNameLong", "firstNameLong"},
+		{"Name", "Name", "Name"},
+		{"raw_ID", "Raw_ID", "raw_ID"},
+		{"version_2", "Version_2", "version_2"},
+		{"trailing_", "Trailing_", "trailing_"},
+	}
+
+	for _, test := range tests {
+		if got := SnakeToPascal(test.snake); got != test.pascal {
+			t.Errorf("SnakeToPascal(%q) = %q, want %q", test.snake, got, test.pascal)
+		}
+		if got := SnakeToCamel(test.snake); got != test.camel {
+			t.Errorf("SnakeToCamel(%q) = %q, want %q", test.snake, got, test.camel)
+		}
+	}
+}
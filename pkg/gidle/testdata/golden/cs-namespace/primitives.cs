@@ -0,0 +1,109 @@
+using System.Text.Json;
+using System.Text.Json.Serialization;
+
+namespace Acme {
+namespace Models {
+public class Primitives {
+[JsonPropertyName("i8")]
+public sbyte I8 { get; set; }
+[JsonPropertyName("i16")]
+public short I16 { get; set; }
+[JsonPropertyName("i32")]
+public int I32 { get; set; }
+[JsonPropertyName("i64")]
+public long I64 { get; set; }
+[JsonPropertyName("u8")]
+public byte U8 { get; set; }
+[JsonPropertyName("u16")]
+public ushort U16 { get; set; }
+[JsonPropertyName("u32")]
+public uint U32 { get; set; }
+[JsonPropertyName("u64")]
+public ulong U64 { get; set; }
+[JsonPropertyName("f32")]
+public float F32 { get; set; }
+[JsonPropertyName("f64")]
+public double F64 { get; set; }
+[JsonPropertyName("flag")]
+public bool Flag { get; set; }
+[JsonPropertyName("text")]
+public string Text { get; set; }
+
+public Primitives(sbyte i8, short i16, int i32, long i64, byte u8, ushort u16, uint u32, ulong u64, float f32, double f64, bool flag, string text) {
+this.I8 = i8;
+this.I16 = i16;
+this.I32 = i32;
+this.I64 = i64;
+this.U8 = u8;
+this.U16 = u16;
+this.U32 = u32;
+this.U64 = u64;
+this.F32 = f32;
+this.F64 = f64;
+this.Flag = flag;
+this.Text = text;
+}
+
+public static Primitives? FromJson(string json) {
+return JsonSerializer.Deserialize<Primitives>(json);
+}
+
+public string ToJson() {
+return JsonSerializer.Serialize(this);
+}
+
+}
+public class Optionals {
+[JsonIgnore(Condition = JsonIgnoreCondition.WhenWritingNull)]
+[JsonPropertyName("count")]
+public int? Count { get; set; }
+[JsonIgnore(Condition = JsonIgnoreCondition.WhenWritingNull)]
+[JsonPropertyName("label")]
+public string? Label { get; set; }
+[JsonIgnore(Condition = JsonIgnoreCondition.WhenWritingNull)]
+[JsonPropertyName("enabled")]
+public bool? Enabled { get; set; }
+[JsonIgnore(Condition = JsonIgnoreCondition.WhenWritingNull)]
+[JsonPropertyName("tags")]
+public List<string>? Tags { get; set; }
+[JsonIgnore(Condition = JsonIgnoreCondition.WhenWritingNull)]
+[JsonPropertyName("totals")]
+public Dictionary<string, long>? Totals { get; set; }
+[JsonIgnore(Condition = JsonIgnoreCondition.WhenWritingNull)]
+[JsonPropertyName("primitives")]
+public Primitives? Primitives { get; set; }
+
+public Optionals(int? count, string? label, bool? enabled, List<string>? tags, Dictionary<string, long>? totals, Primitives? primitives) {
+this.Count = count;
+this.Label = label;
+this.Enabled = enabled;
+this.Tags = tags;
+this.Totals = totals;
+this.Primitives = primitives;
+}
+
+public static Optionals? FromJson(string json) {
+return JsonSerializer.Deserialize<Optionals>(json);
+}
+
+public string ToJson() {
+return JsonSerializer.Serialize(this);
+}
+
+}
+public class Empty {
+
+public Empty() {
+}
+
+public static Empty? FromJson(string json) {
+return JsonSerializer.Deserialize<Empty>(json);
+}
+
+public string ToJson() {
+return JsonSerializer.Serialize(this);
+}
+
+}
+}
+}
@@ -0,0 +1,79 @@
+using System.Text.Json;
+using System.Text.Json.Serialization;
+
+namespace acme {
+namespace annotations {
+/// <summary>
+/// How an event was delivered.
+/// </summary>
+public class Channel {
+	/// <summary>
+	/// Not set.
+	/// </summary>
+	public const int NONE = 0;
+	/// <summary>
+	/// Sent by email.
+	/// </summary>
+	public const int EMAIL = 1;
+public static int IndexOf(int value) {
+return value switch {
+NONE => 0,
+EMAIL => 1,
+_ => throw new ArgumentOutOfRangeException(nameof(value), "Invalid value")
+};
+}
+public static int ValueOf(int index) {
+return index switch {
+0 => NONE,
+1 => EMAIL,
+_ => throw new ArgumentOutOfRangeException(nameof(index), "Invalid index")
+};
+}
+}
+/// <summary>
+/// Retry settings.
+/// </summary>
+public static class Retry {
+/// <summary>
+/// Attempts before giving up.
+/// </summary>
+public const int ATTEMPTS = 3;
+}
+/// <summary>
+/// An event from a third party.
+/// </summary>
+public class Event {
+/// <summary>
+/// Type of the event.
+/// </summary>
+[JsonPropertyName("@type")]
+public string TypeName { get; set; }
+[JsonIgnore(Condition = JsonIgnoreCondition.WhenWritingNull)]
+[JsonPropertyName("user-id")]
+public string? UserId { get; set; }
+/// <summary>
+/// Delivery channel.
+/// </summary>
+[JsonPropertyName("channel")]
+public Channel Channel { get; set; }
+[JsonPropertyName("created_at")]
+public long CreatedAt { get; set; }
+
+public Event(string type_name, string? user_id, Channel channel, long created_at) {
+this.TypeName = type_name;
+this.UserId = user_id;
+this.Channel = channel;
+this.CreatedAt = created_at;
+}
+
+public static Event? FromJson(string json) {
+return JsonSerializer.Deserialize<Event>(json);
+}
+
+public string ToJson() {
+return JsonSerializer.Serialize(this);
+}
+
+}
+}
+}
@@ -0,0 +1,87 @@
+using System.Text.Json;
+using System.Text.Json.Serialization;
+
+namespace acme {
+namespace collections {
+public class Color {
+	public const byte RED = 0;
+	public const byte GREEN = 1;
+public static int IndexOf(byte value) {
+return value switch {
+RED => 0,
+GREEN => 1,
+_ => throw new ArgumentOutOfRangeException(nameof(value), "Invalid value")
+};
+}
+public static byte ValueOf(int index) {
+return index switch {
+0 => RED,
+1 => GREEN,
+_ => throw new ArgumentOutOfRangeException(nameof(index), "Invalid index")
+};
+}
+}
+public class Point {
+[JsonPropertyName("x")]
+public double X { get; set; }
+[JsonPropertyName("y")]
+public double Y { get; set; }
+
+public Point(double x, double y) {
+this.X = x;
+this.Y = y;
+}
+
+public static Point? FromJson(string json) {
+return JsonSerializer.Deserialize<Point>(json);
+}
+
+public string ToJson() {
+return JsonSerializer.Serialize(this);
+}
+
+}
+public class Shape {
+[JsonPropertyName("points")]
+public List<Point> Points { get; set; }
+[JsonPropertyName("grid")]
+public List<List<int>> Grid { get; set; }
+[JsonPropertyName("named")]
+public Dictionary<string, Point> Named { get; set; }
+[JsonPropertyName("palette")]
+public Dictionary<ushort, List<Color>> Palette { get; set; }
+[JsonPropertyName("weights")]
+public List<Dictionary<string, float>> Weights { get; set; }
+[JsonPropertyName("flags")]
+public Dictionary<long, Dictionary<string, bool>> Flags { get; set; }
+[JsonPropertyName("counts")]
+public List<Dictionary<string, int>> Counts { get; set; }
+[JsonPropertyName("aliases")]
+public Dictionary<string, List<string>> Aliases { get; set; }
+[JsonIgnore(Condition = JsonIgnoreCondition.WhenWritingNull)]
+[JsonPropertyName("colors")]
+public List<Color>? Colors { get; set; }
+
+public Shape(List<Point> points, List<List<int>> grid, Dictionary<string, Point> named, Dictionary<ushort, List<Color>> palette, List<Dictionary<string, float>> weights, Dictionary<long, Dictionary<string, bool>> flags, List<Dictionary<string, int>> counts, Dictionary<string, List<string>> aliases, List<Color>? colors) {
+this.Points = points;
+this.Grid = grid;
+this.Named = named;
+this.Palette = palette;
+this.Weights = weights;
+this.Flags = flags;
+this.Counts = counts;
+this.Aliases = aliases;
+this.Colors = colors;
+}
+
+public static Shape? FromJson(string json) {
+return JsonSerializer.Deserialize<Shape>(json);
+}
+
+public string ToJson() {
+return JsonSerializer.Serialize(this);
+}
+
+}
+}
+}
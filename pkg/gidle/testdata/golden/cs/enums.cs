@@ -0,0 +1,120 @@
+using System.Text.Json;
+using System.Text.Json.Serialization;
+
+namespace acme {
+namespace enums {
+public class Status {
+	public const int UNKNOWN = 0;
+	public const int ACTIVE = 1;
+	public const int DISABLED = 2;
+public static int IndexOf(int value) {
+return value switch {
+UNKNOWN => 0,
+ACTIVE => 1,
+DISABLED => 2,
+_ => throw new ArgumentOutOfRangeException(nameof(value), "Invalid value")
+};
+}
+public static int ValueOf(int index) {
+return index switch {
+0 => UNKNOWN,
+1 => ACTIVE,
+2 => DISABLED,
+_ => throw new ArgumentOutOfRangeException(nameof(index), "Invalid index")
+};
+}
+}
+public class Size {
+	public const ulong SMALL = 1;
+	public const ulong LARGE = 1000000;
+public static int IndexOf(ulong value) {
+return value switch {
+SMALL => 0,
+LARGE => 1,
+_ => throw new ArgumentOutOfRangeException(nameof(value), "Invalid value")
+};
+}
+public static ulong ValueOf(int index) {
+return index switch {
+0 => SMALL,
+1 => LARGE,
+_ => throw new ArgumentOutOfRangeException(nameof(index), "Invalid index")
+};
+}
+}
+public class Mode {
+	public const string READ = "read";
+	public const string WRITE = "write";
+public static int IndexOf(string value) {
+return value switch {
+READ => 0,
+WRITE => 1,
+_ => throw new ArgumentOutOfRangeException(nameof(value), "Invalid value")
+};
+}
+public static string ValueOf(int index) {
+return index switch {
+0 => READ,
+1 => WRITE,
+_ => throw new ArgumentOutOfRangeException(nameof(index), "Invalid index")
+};
+}
+}
+public class Switch {
+	public const bool ON = true;
+	public const bool OFF = false;
+public static int IndexOf(bool value) {
+return value switch {
+ON => 0,
+OFF => 1,
+_ => throw new ArgumentOutOfRangeException(nameof(value), "Invalid value")
+};
+}
+public static bool ValueOf(int index) {
+return index switch {
+0 => ON,
+1 => OFF,
+_ => throw new ArgumentOutOfRangeException(nameof(index), "Invalid index")
+};
+}
+}
+public static class Limits {
+public const long MIN = 0;
+public const long MAX = 10;
+}
+public static class Ratios {
+public const float HALF = 0.5;
+public const float ONE = 1;
+}
+public static class Names {
+public const string SERVICE = "acme";
+}
+public static class Flags {
+public const bool ENABLED = true;
+}
+public class Account {
+[JsonPropertyName("status")]
+public Status Status { get; set; }
+[JsonIgnore(Condition = JsonIgnoreCondition.WhenWritingNull)]
+[JsonPropertyName("mode")]
+public Mode? Mode { get; set; }
+[JsonPropertyName("power")]
+public Switch Power { get; set; }
+
+public Account(Status status, Mode? mode, Switch power) {
+this.Status = status;
+this.Mode = mode;
+this.Power = power;
+}
+
+public static Account? FromJson(string json) {
+return JsonSerializer.Deserialize<Account>(json);
+}
+
+public string ToJson() {
+return JsonSerializer.Serialize(this);
+}
+
+}
+}
+}
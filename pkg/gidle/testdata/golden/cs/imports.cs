@@ -0,0 +1,39 @@
+using System.Text.Json;
+using System.Text.Json.Serialization;
+using common = acme.common;
+using shared = acme.common;
+
+namespace acme {
+namespace people {
+public class Person {
+[JsonPropertyName("name")]
+public string Name { get; set; }
+[JsonPropertyName("home")]
+public common.Address Home { get; set; }
+[JsonIgnore(Condition = JsonIgnoreCondition.WhenWritingNull)]
+[JsonPropertyName("work")]
+public shared.Address? Work { get; set; }
+[JsonPropertyName("kind")]
+public common.Kind Kind { get; set; }
+[JsonPropertyName("previous")]
+public List<common.Address> Previous { get; set; }
+
+public Person(string name, common.Address home, shared.Address? work, common.Kind kind, List<common.Address> previous) {
+this.Name = name;
+this.Home = home;
+this.Work = work;
+this.Kind = kind;
+this.Previous = previous;
+}
+
+public static Person? FromJson(string json) {
+return JsonSerializer.Deserialize<Person>(json);
+}
+
+public string ToJson() {
+return JsonSerializer.Serialize(this);
+}
+
+}
+}
+}
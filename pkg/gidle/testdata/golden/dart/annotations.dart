@@ -0,0 +1,62 @@
+import 'dart:convert';
+
+/// How an event was delivered.
+enum Channel {
+	/// Not set.
+	NONE(0),
+	/// Sent by email.
+	EMAIL(1);
+
+	final int value;
+
+	const Channel(this.value);
+
+	static Channel fromValue(int value) {
+		return Channel.values.firstWhere((e) => e.value == value, orElse: () => throw ArgumentError.value(value, "value", "unknown Channel value"));
+	}
+}
+
+/// Retry settings.
+///
+/// Attempts before giving up.
+const Retry_ATTEMPTS = 3;
+
+/// An event from a third party.
+class Event {
+	/// Type of the event.
+	String typeName;
+	String? userId;
+	/// Delivery channel.
+	Channel channel;
+	int createdAt;
+
+	Event({
+		required this.typeName,
+		this.userId,
+		required this.channel,
+		required this.createdAt,
+	});
+
+	Map<String, dynamic> toMap() {
+		return {
+			"@type": typeName,
+			"user-id": userId,
+			"channel": channel.value,
+			"created_at": createdAt,
+		};
+	}
+
+	String toJson() {
+		return jsonEncode(toMap());
+	}
+
+	Event.fromMap(Map<String, dynamic> map)
+		: typeName = map["@type"],
+		  userId = map["user-id"],
+		  channel = Channel.fromValue(map["channel"]),
+		  createdAt = map["created_at"];
+
+	Event.fromJson(String source) : this.fromMap(jsonDecode(source));
+
+}
+
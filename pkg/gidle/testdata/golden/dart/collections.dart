@@ -0,0 +1,99 @@
+import 'dart:convert';
+
+enum Color {
+	RED(0),
+	GREEN(1);
+
+	final int value;
+
+	const Color(this.value);
+
+	static Color fromValue(int value) {
+		return Color.values.firstWhere((e) => e.value == value, orElse: () => throw ArgumentError.value(value, "value", "unknown Color value"));
+	}
+}
+
+class Point {
+	double x;
+	double y;
+
+	Point({
+		required this.x,
+		required this.y,
+	});
+
+	Map<String, dynamic> toMap() {
+		return {
+			"x": x,
+			"y": y,
+		};
+	}
+
+	String toJson() {
+		return jsonEncode(toMap());
+	}
+
+	Point.fromMap(Map<String, dynamic> map)
+		: x = (map["x"] as num).toDouble(),
+		  y = (map["y"] as num).toDouble();
+
+	Point.fromJson(String source) : this.fromMap(jsonDecode(source));
+
+}
+
+class Shape {
+	List<Point> points;
+	List<List<int>> grid;
+	Map<String, Point> named;
+	Map<int, List<Color>> palette;
+	List<Map<String, double>> weights;
+	Map<int, Map<String, bool>> flags;
+	List<Map<String, int>> counts;
+	Map<String, List<String>> aliases;
+	List<Color>? colors;
+
+	Shape({
+		required this.points,
+		required this.grid,
+		required this.named,
+		required this.palette,
+		required this.weights,
+		required this.flags,
+		required this.counts,
+		required this.aliases,
+		this.colors,
+	});
+
+	Map<String, dynamic> toMap() {
+		return {
+			"points": points.map((e) => e.toMap()).toList(),
+			"grid": grid,
+			"named": named.map((k, v) => MapEntry(k, v.toMap())),
+			"palette": palette.map((k, v) => MapEntry(k.toString(), v.map((e) => e.value).toList())),
+			"weights": weights,
+			"flags": flags.map((k, v) => MapEntry(k.toString(), v)),
+			"counts": counts,
+			"aliases": aliases,
+			"colors": colors?.map((e) => e.value).toList(),
+		};
+	}
+
+	String toJson() {
+		return jsonEncode(toMap());
+	}
+
+	Shape.fromMap(Map<String, dynamic> map)
+		: points = List<Point>.from((map["points"] as List).map((e) => Point.fromMap(e))),
+		  grid = List<List<int>>.from((map["grid"] as List).map((e) => List<int>.from(e))),
+		  named = Map<String, Point>.from((map["named"] as Map).map((k, v) => MapEntry(k, Point.fromMap(v)))),
+		  palette = Map<int, List<Color>>.from((map["palette"] as Map).map((k, v) => MapEntry(int.parse(k), List<Color>.from((v as List).map((e) => Color.fromValue(e)))))),
+		  weights = List<Map<String, double>>.from((map["weights"] as List).map((e) => Map<String, double>.from((e as Map).map((k, v) => MapEntry(k, (v as num).toDouble()))))),
+		  flags = Map<int, Map<String, bool>>.from((map["flags"] as Map).map((k, v) => MapEntry(int.parse(k), Map<String, bool>.from(v)))),
+		  counts = List<Map<String, int>>.from((map["counts"] as List).map((e) => Map<String, int>.from(e))),
+		  aliases = Map<String, List<String>>.from((map["aliases"] as Map).map((k, v) => MapEntry(k, List<String>.from(v)))),
+		  colors = map["colors"] == null ? null : List<Color>.from((map["colors"] as List).map((e) => Color.fromValue(e)));
+
+	Shape.fromJson(String source) : this.fromMap(jsonDecode(source));
+
+}
+
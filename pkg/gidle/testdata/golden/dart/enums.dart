@@ -0,0 +1,97 @@
+import 'dart:convert';
+
+enum Status {
+	UNKNOWN(0),
+	ACTIVE(1),
+	DISABLED(2);
+
+	final int value;
+
+	const Status(this.value);
+
+	static Status fromValue(int value) {
+		return Status.values.firstWhere((e) => e.value == value, orElse: () => throw ArgumentError.value(value, "value", "unknown Status value"));
+	}
+}
+
+enum Size {
+	SMALL(1),
+	LARGE(1000000);
+
+	final int value;
+
+	const Size(this.value);
+
+	static Size fromValue(int value) {
+		return Size.values.firstWhere((e) => e.value == value, orElse: () => throw ArgumentError.value(value, "value", "unknown Size value"));
+	}
+}
+
+enum Mode {
+	READ("read"),
+	WRITE("write");
+
+	final String value;
+
+	const Mode(this.value);
+
+	static Mode fromValue(String value) {
+		return Mode.values.firstWhere((e) => e.value == value, orElse: () => throw ArgumentError.value(value, "value", "unknown Mode value"));
+	}
+}
+
+enum Switch {
+	ON(true),
+	OFF(false);
+
+	final bool value;
+
+	const Switch(this.value);
+
+	static Switch fromValue(bool value) {
+		return Switch.values.firstWhere((e) => e.value == value, orElse: () => throw ArgumentError.value(value, "value", "unknown Switch value"));
+	}
+}
+
+const Limits_MIN = 0;
+const Limits_MAX = 10;
+
+const Ratios_HALF = 0.5;
+const Ratios_ONE = 1;
+
+const Names_SERVICE = "acme";
+
+const Flags_ENABLED = true;
+
+class Account {
+	Status status;
+	Mode? mode;
+	Switch power;
+
+	Account({
+		required this.status,
+		this.mode,
+		required this.power,
+	});
+
+	Map<String, dynamic> toMap() {
+		return {
+			"status": status.value,
+			"mode": mode?.value,
+			"power": power.value,
+		};
+	}
+
+	String toJson() {
+		return jsonEncode(toMap());
+	}
+
+	Account.fromMap(Map<String, dynamic> map)
+		: status = Status.fromValue(map["status"]),
+		  mode = map["mode"] == null ? null : Mode.fromValue(map["mode"]),
+		  power = Switch.fromValue(map["power"]);
+
+	Account.fromJson(String source) : this.fromMap(jsonDecode(source));
+
+}
+
@@ -0,0 +1,44 @@
+import 'dart:convert';
+import 'common/types.dart' as common;
+import 'common/types.dart' as shared;
+
+class Person {
+	String name;
+	common.Address home;
+	shared.Address? work;
+	common.Kind kind;
+	List<common.Address> previous;
+
+	Person({
+		required this.name,
+		required this.home,
+		this.work,
+		required this.kind,
+		required this.previous,
+	});
+
+	Map<String, dynamic> toMap() {
+		return {
+			"name": name,
+			"home": home.toMap(),
+			"work": work?.toMap(),
+			"kind": kind.value,
+			"previous": previous.map((e) => e.toMap()).toList(),
+		};
+	}
+
+	String toJson() {
+		return jsonEncode(toMap());
+	}
+
+	Person.fromMap(Map<String, dynamic> map)
+		: name = map["name"],
+		  home = common.Address.fromMap(map["home"] ?? {}),
+		  work = map["work"] == null ? null : shared.Address.fromMap(map["work"]),
+		  kind = common.Kind.fromValue(map["kind"]),
+		  previous = List<common.Address>.from((map["previous"] as List).map((e) => common.Address.fromMap(e)));
+
+	Person.fromJson(String source) : this.fromMap(jsonDecode(source));
+
+}
+
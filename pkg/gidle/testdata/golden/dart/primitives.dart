@@ -0,0 +1,134 @@
+import 'dart:convert';
+
+class Primitives {
+	int i8;
+	int i16;
+	int i32;
+	int i64;
+	int u8;
+	int u16;
+	int u32;
+	int u64;
+	double f32;
+	double f64;
+	bool flag;
+	String text;
+
+	Primitives({
+		required this.i8,
+		required this.i16,
+		required this.i32,
+		required this.i64,
+		required this.u8,
+		required this.u16,
+		required this.u32,
+		required this.u64,
+		required this.f32,
+		required this.f64,
+		required this.flag,
+		required this.text,
+	});
+
+	Map<String, dynamic> toMap() {
+		return {
+			"i8": i8,
+			"i16": i16,
+			"i32": i32,
+			"i64": i64,
+			"u8": u8,
+			"u16": u16,
+			"u32": u32,
+			"u64": u64,
+			"f32": f32,
+			"f64": f64,
+			"flag": flag,
+			"text": text,
+		};
+	}
+
+	String toJson() {
+		return jsonEncode(toMap());
+	}
+
+	Primitives.fromMap(Map<String, dynamic> map)
+		: i8 = map["i8"],
+		  i16 = map["i16"],
+		  i32 = map["i32"],
+		  i64 = map["i64"],
+		  u8 = map["u8"],
+		  u16 = map["u16"],
+		  u32 = map["u32"],
+		  u64 = map["u64"],
+		  f32 = (map["f32"] as num).toDouble(),
+		  f64 = (map["f64"] as num).toDouble(),
+		  flag = map["flag"],
+		  text = map["text"];
+
+	Primitives.fromJson(String source) : this.fromMap(jsonDecode(source));
+
+}
+
+class Optionals {
+	int? count;
+	String? label;
+	bool? enabled;
+	List<String>? tags;
+	Map<String, int>? totals;
+	Primitives? primitives;
+
+	Optionals({
+		this.count,
+		this.label,
+		this.enabled,
+		this.tags,
+		this.totals,
+		this.primitives,
+	});
+
+	Map<String, dynamic> toMap() {
+		return {
+			"count": count,
+			"label": label,
+			"enabled": enabled,
+			"tags": tags,
+			"totals": totals,
+			"primitives": primitives?.toMap(),
+		};
+	}
+
+	String toJson() {
+		return jsonEncode(toMap());
+	}
+
+	Optionals.fromMap(Map<String, dynamic> map)
+		: count = map["count"],
+		  label = map["label"],
+		  enabled = map["enabled"],
+		  tags = map["tags"] == null ? null : List<String>.from(map["tags"]),
+		  totals = map["totals"] == null ? null : Map<String, int>.from(map["totals"]),
+		  primitives = map["primitives"] == null ? null : Primitives.fromMap(map["primitives"]);
+
+	Optionals.fromJson(String source) : this.fromMap(jsonDecode(source));
+
+}
+
+class Empty {
+
+	Empty({
+	});
+
+	Map<String, dynamic> toMap() {
+		return {
+		};
+	}
+
+	String toJson() {
+		return jsonEncode(toMap());
+	}
+
+	Empty.fromMap(Map<String, dynamic> map);
+
+	Empty.fromJson(String source) : this.fromMap(jsonDecode(source));
+
+}
+
@@ -0,0 +1,60 @@
+package model
+
+// How an event was delivered.
+type Channel int32
+
+const (
+	// Not set.
+	Channel_NONE = Channel(0)
+	// Sent by email.
+	Channel_EMAIL = Channel(1)
+)
+
+func (e Channel) String() string {
+	switch e {
+	case Channel_NONE:
+		return "NONE"
+	case Channel_EMAIL:
+		return "EMAIL"
+	default:
+		return "unknown enum value"
+	}
+}
+
+func GetChannel(index int) (value Channel, ok bool) {
+	switch index {
+	case 0:
+		return Channel_NONE, true
+	case 1:
+		return Channel_EMAIL, true
+	default:
+		return value, false
+	}
+}
+
+func IndexOfChannel(value Channel) (index int, ok bool) {
+	switch value {
+	case Channel_NONE:
+		return 0, true
+	case Channel_EMAIL:
+		return 1, true
+	default:
+		return index, false
+	}
+}
+
+// Retry settings.
+const (
+	// Attempts before giving up.
+	Retry_ATTEMPTS = 3
+)
+
+// An event from a third party.
+type Event struct {
+	// Type of the event.
+	TypeName string  `json:"@type"`
+	UserId   *string `json:"user-id,omitempty"`
+	// Delivery channel.
+	Channel   Channel `json:"channel"`
+	CreatedAt int64   `json:"created_at"`
+}
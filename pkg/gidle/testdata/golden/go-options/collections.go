@@ -0,0 +1,58 @@
+package model
+
+type Color uint8
+
+const (
+	Color_RED   = Color(0)
+	Color_GREEN = Color(1)
+)
+
+func (e Color) String() string {
+	switch e {
+	case Color_RED:
+		return "RED"
+	case Color_GREEN:
+		return "GREEN"
+	default:
+		return "unknown enum value"
+	}
+}
+
+func GetColor(index int) (value Color, ok bool) {
+	switch index {
+	case 0:
+		return Color_RED, true
+	case 1:
+		return Color_GREEN, true
+	default:
+		return value, false
+	}
+}
+
+func IndexOfColor(value Color) (index int, ok bool) {
+	switch value {
+	case Color_RED:
+		return 0, true
+	case Color_GREEN:
+		return 1, true
+	default:
+		return index, false
+	}
+}
+
+type Point struct {
+	X float64 `json:"x"`
+	Y float64 `json:"y"`
+}
+
+type Shape struct {
+	Points  []Point                   `json:"points"`
+	Grid    [][]int32                 `json:"grid"`
+	Named   map[string]Point          `json:"named"`
+	Palette map[uint16][]Color        `json:"palette"`
+	Weights []map[string]float32      `json:"weights"`
+	Flags   map[int64]map[string]bool `json:"flags"`
+	Counts  []map[string]int32        `json:"counts"`
+	Aliases map[string][]string       `json:"aliases"`
+	Colors  []Color                   `json:"colors,omitempty"`
+}
@@ -0,0 +1,14 @@
+package model
+
+import (
+	common "example.com/gen/acme/common"
+	shared "example.com/gen/acme/common"
+)
+
+type Person struct {
+	Name     string           `json:"name"`
+	Home     common.Address   `json:"home"`
+	Work     *shared.Address  `json:"work,omitempty"`
+	Kind     common.Kind      `json:"kind"`
+	Previous []common.Address `json:"previous"`
+}
@@ -0,0 +1,28 @@
+package model
+
+type Primitives struct {
+	I8   int8    `json:"i8"`
+	I16  int16   `json:"i16"`
+	I32  int32   `json:"i32"`
+	I64  int64   `json:"i64"`
+	U8   uint8   `json:"u8"`
+	U16  uint16  `json:"u16"`
+	U32  uint32  `json:"u32"`
+	U64  uint64  `json:"u64"`
+	F32  float32 `json:"f32"`
+	F64  float64 `json:"f64"`
+	Flag bool    `json:"flag"`
+	Text string  `json:"text"`
+}
+
+type Optionals struct {
+	Count      *int32           `json:"count,omitempty"`
+	Label      *string          `json:"label,omitempty"`
+	Enabled    *bool            `json:"enabled,omitempty"`
+	Tags       []string         `json:"tags,omitempty"`
+	Totals     map[string]int64 `json:"totals,omitempty"`
+	Primitives *Primitives      `json:"primitives,omitempty"`
+}
+
+type Empty struct {
+}
@@ -0,0 +1,192 @@
+package enums
+
+type Status int32
+
+const (
+	Status_UNKNOWN  = Status(0)
+	Status_ACTIVE   = Status(1)
+	Status_DISABLED = Status(2)
+)
+
+func (e Status) String() string {
+	switch e {
+	case Status_UNKNOWN:
+		return "UNKNOWN"
+	case Status_ACTIVE:
+		return "ACTIVE"
+	case Status_DISABLED:
+		return "DISABLED"
+	default:
+		return "unknown enum value"
+	}
+}
+
+func GetStatus(index int) (value Status, ok bool) {
+	switch index {
+	case 0:
+		return Status_UNKNOWN, true
+	case 1:
+		return Status_ACTIVE, true
+	case 2:
+		return Status_DISABLED, true
+	default:
+		return value, false
+	}
+}
+
+func IndexOfStatus(value Status) (index int, ok bool) {
+	switch value {
+	case Status_UNKNOWN:
+		return 0, true
+	case Status_ACTIVE:
+		return 1, true
+	case Status_DISABLED:
+		return 2, true
+	default:
+		return index, false
+	}
+}
+
+type Size uint64
+
+const (
+	Size_SMALL = Size(1)
+	Size_LARGE = Size(1000000)
+)
+
+func (e Size) String() string {
+	switch e {
+	case Size_SMALL:
+		return "SMALL"
+	case Size_LARGE:
+		return "LARGE"
+	default:
+		return "unknown enum value"
+	}
+}
+
+func GetSize(index int) (value Size, ok bool) {
+	switch index {
+	case 0:
+		return Size_SMALL, true
+	case 1:
+		return Size_LARGE, true
+	default:
+		return value, false
+	}
+}
+
+func IndexOfSize(value Size) (index int, ok bool) {
+	switch value {
+	case Size_SMALL:
+		return 0, true
+	case Size_LARGE:
+		return 1, true
+	default:
+		return index, false
+	}
+}
+
+type Mode string
+
+const (
+	Mode_READ  = Mode("read")
+	Mode_WRITE = Mode("write")
+)
+
+func (e Mode) String() string {
+	switch e {
+	case Mode_READ:
+		return "READ"
+	case Mode_WRITE:
+		return "WRITE"
+	default:
+		return "unknown enum value"
+	}
+}
+
+func GetMode(index int) (value Mode, ok bool) {
+	switch index {
+	case 0:
+		return Mode_READ, true
+	case 1:
+		return Mode_WRITE, true
+	default:
+		return value, false
+	}
+}
+
+func IndexOfMode(value Mode) (index int, ok bool) {
+	switch value {
+	case Mode_READ:
+		return 0, true
+	case Mode_WRITE:
+		return 1, true
+	default:
+		return index, false
+	}
+}
+
+type Switch bool
+
+const (
+	Switch_ON  = Switch(true)
+	Switch_OFF = Switch(false)
+)
+
+func (e Switch) String() string {
+	switch e {
+	case Switch_ON:
+		return "ON"
+	case Switch_OFF:
+		return "OFF"
+	default:
+		return "unknown enum value"
+	}
+}
+
+func GetSwitch(index int) (value Switch, ok bool) {
+	switch index {
+	case 0:
+		return Switch_ON, true
+	case 1:
+		return Switch_OFF, true
+	default:
+		return value, false
+	}
+}
+
+func IndexOfSwitch(value Switch) (index int, ok bool) {
+	switch value {
+	case Switch_ON:
+		return 0, true
+	case Switch_OFF:
+		return 1, true
+	default:
+		return index, false
+	}
+}
+
+const (
+	Limits_MIN = 0
+	Limits_MAX = 10
+)
+
+const (
+	Ratios_HALF = 0.5
+	Ratios_ONE  = 1
+)
+
+const (
+	Names_SERVICE = "acme"
+)
+
+const (
+	Flags_ENABLED = true
+)
+
+type Account struct {
+	Status Status `json:"status"`
+	Mode   *Mode  `json:"mode,omitempty"`
+	Power  Switch `json:"power"`
+}
@@ -0,0 +1,14 @@
+package people
+
+import (
+	common "acme/common"
+	shared "acme/common"
+)
+
+type Person struct {
+	Name     string           `json:"name"`
+	Home     common.Address   `json:"home"`
+	Work     *shared.Address  `json:"work,omitempty"`
+	Kind     common.Kind      `json:"kind"`
+	Previous []common.Address `json:"previous"`
+}
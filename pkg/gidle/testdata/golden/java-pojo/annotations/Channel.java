@@ -0,0 +1,39 @@
+package acme.annotations;
+
+import com.fasterxml.jackson.annotation.JsonCreator;
+import com.fasterxml.jackson.annotation.JsonValue;
+
+/**
+ * How an event was delivered.
+ */
+public enum Channel {
+    /**
+     * Not set.
+     */
+    NONE(0),
+    /**
+     * Sent by email.
+     */
+    EMAIL(1);
+
+    private final int value;
+
+    Channel(int value) {
+        this.value = value;
+    }
+
+    @JsonValue
+    public int getValue() {
+        return value;
+    }
+
+    @JsonCreator(mode = JsonCreator.Mode.DELEGATING)
+    public static Channel fromValue(int value) {
+        for (Channel v : values()) {
+            if (v.value == value) {
+                return v;
+            }
+        }
+        throw new IllegalArgumentException("unknown Channel value " + value);
+    }
+}
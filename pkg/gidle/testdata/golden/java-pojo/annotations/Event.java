@@ -0,0 +1,86 @@
+package acme.annotations;
+
+import com.fasterxml.jackson.annotation.JsonInclude;
+import com.fasterxml.jackson.annotation.JsonProperty;
+import java.util.Objects;
+
+/**
+ * An event from a third party.
+ */
+public class Event {
+    /**
+     * Type of the event.
+     */
+    @JsonProperty("@type")
+    private String typeName;
+    @JsonProperty("user-id") @JsonInclude(JsonInclude.Include.NON_NULL)
+    private String userId;
+    /**
+     * Delivery channel.
+     */
+    @JsonProperty("channel")
+    private Channel channel;
+    @JsonProperty("created_at")
+    private long createdAt;
+
+    public Event() {
+    }
+
+    public Event(String typeName, String userId, Channel channel, long createdAt) {
+        this.typeName = typeName;
+        this.userId = userId;
+        this.channel = channel;
+        this.createdAt = createdAt;
+    }
+
+    public String getTypeName() {
+        return typeName;
+    }
+
+    public void setTypeName(String typeName) {
+        this.typeName = typeName;
+    }
+
+    public String getUserId() {
+        return userId;
+    }
+
+    public void setUserId(String userId) {
+        this.userId = userId;
+    }
+
+    public Channel getChannel() {
+        return channel;
+    }
+
+    public void setChannel(Channel channel) {
+        this.channel = channel;
+    }
+
+    public long getCreatedAt() {
+        return createdAt;
+    }
+
+    public void setCreatedAt(long createdAt) {
+        this.createdAt = createdAt;
+    }
+
+    @Override
+    public boolean equals(Object o) {
+        if (this == o) {
+            return true;
+        }
+        if (!(o instanceof Event other)) {
+            return false;
+        }
+        return Objects.equals(typeName, other.typeName)
+            && Objects.equals(userId, other.userId)
+            && Objects.equals(channel, other.channel)
+            && Objects.equals(createdAt, other.createdAt);
+    }
+
+    @Override
+    public int hashCode() {
+        return Objects.hash(typeName, userId, channel, createdAt);
+    }
+}
@@ -0,0 +1,14 @@
+package acme.annotations;
+
+/**
+ * Retry settings.
+ */
+public final class Retry {
+    /**
+     * Attempts before giving up.
+     */
+    public static final int ATTEMPTS = 3;
+
+    private Retry() {
+    }
+}
@@ -0,0 +1,30 @@
+package acme.collections;
+
+import com.fasterxml.jackson.annotation.JsonCreator;
+import com.fasterxml.jackson.annotation.JsonValue;
+
+public enum Color {
+    RED((short) 0),
+    GREEN((short) 1);
+
+    private final short value;
+
+    Color(short value) {
+        this.value = value;
+    }
+
+    @JsonValue
+    public short getValue() {
+        return value;
+    }
+
+    @JsonCreator(mode = JsonCreator.Mode.DELEGATING)
+    public static Color fromValue(short value) {
+        for (Color v : values()) {
+            if (v.value == value) {
+                return v;
+            }
+        }
+        throw new IllegalArgumentException("unknown Color value " + value);
+    }
+}
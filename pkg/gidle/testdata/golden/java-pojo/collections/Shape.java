@@ -0,0 +1,139 @@
+package acme.collections;
+
+import com.fasterxml.jackson.annotation.JsonInclude;
+import com.fasterxml.jackson.annotation.JsonProperty;
+import java.util.List;
+import java.util.Map;
+import java.util.Objects;
+
+public class Shape {
+    @JsonProperty("points")
+    private List<Point> points;
+    @JsonProperty("grid")
+    private List<List<Integer>> grid;
+    @JsonProperty("named")
+    private Map<String, Point> named;
+    @JsonProperty("palette")
+    private Map<Integer, List<Color>> palette;
+    @JsonProperty("weights")
+    private List<Map<String, Float>> weights;
+    @JsonProperty("flags")
+    private Map<Long, Map<String, Boolean>> flags;
+    @JsonProperty("counts")
+    private List<Map<String, Integer>> counts;
+    @JsonProperty("aliases")
+    private Map<String, List<String>> aliases;
+    @JsonProperty("colors") @JsonInclude(JsonInclude.Include.NON_NULL)
+    private List<Color> colors;
+
+    public Shape() {
+    }
+
+    public Shape(List<Point> points, List<List<Integer>> grid, Map<String, Point> named, Map<Integer, List<Color>> palette, List<Map<String, Float>> weights, Map<Long, Map<String, Boolean>> flags, List<Map<String, Integer>> counts, Map<String, List<String>> aliases, List<Color> colors) {
+        this.points = points;
+        this.grid = grid;
+        this.named = named;
+        this.palette = palette;
+        this.weights = weights;
+        this.flags = flags;
+        this.counts = counts;
+        this.aliases = aliases;
+        this.colors = colors;
+    }
+
+    public List<Point> getPoints() {
+        return points;
+    }
+
+    public void setPoints(List<Point> points) {
+        this.points = points;
+    }
+
+    public List<List<Integer>> getGrid() {
+        return grid;
+    }
+
+    public void setGrid(List<List<Integer>> grid) {
+        this.grid = grid;
+    }
+
+    public Map<String, Point> getNamed() {
+        return named;
+    }
+
+    public void setNamed(Map<String, Point> named) {
+        this.named = named;
+    }
+
+    public Map<Integer, List<Color>> getPalette() {
+        return palette;
+    }
+
+    public void setPalette(Map<Integer, List<Color>> palette) {
+        this.palette = palette;
+    }
+
+    public List<Map<String, Float>> getWeights() {
+        return weights;
+    }
+
+    public void setWeights(List<Map<String, Float>> weights) {
+        this.weights = weights;
+    }
+
+    public Map<Long, Map<String, Boolean>> getFlags() {
+        return flags;
+    }
+
+    public void setFlags(Map<Long, Map<String, Boolean>> flags) {
+        this.flags = flags;
+    }
+
+    public List<Map<String, Integer>> getCounts() {
+        return counts;
+    }
+
+    public void setCounts(List<Map<String, Integer>> counts) {
+        this.counts = counts;
+    }
+
+    public Map<String, List<String>> getAliases() {
+        return aliases;
+    }
+
+    public void setAliases(Map<String, List<String>> aliases) {
+        this.aliases = aliases;
+    }
+
+    public List<Color> getColors() {
+        return colors;
+    }
+
+    public void setColors(List<Color> colors) {
+        this.colors = colors;
+    }
+
+    @Override
+    public boolean equals(Object o) {
+        if (this == o) {
+            return true;
+        }
+        if (!(o instanceof Shape other)) {
+            return false;
+        }
+        return Objects.equals(points, other.points)
+            && Objects.equals(grid, other.grid)
+            && Objects.equals(named, other.named)
+            && Objects.equals(palette, other.palette)
+            && Objects.equals(weights, other.weights)
+            && Objects.equals(flags, other.flags)
+            && Objects.equals(counts, other.counts)
+            && Objects.equals(aliases, other.aliases)
+            && Objects.equals(colors, other.colors);
+    }
+
+    @Override
+    public int hashCode() {
+        return Objects.hash(points, grid, named, palette, weights, flags, counts, aliases, colors);
+    }
+}
@@ -0,0 +1,65 @@
+package acme.enums;
+
+import com.fasterxml.jackson.annotation.JsonInclude;
+import com.fasterxml.jackson.annotation.JsonProperty;
+import java.util.Objects;
+
+public class Account {
+    @JsonProperty("status")
+    private Status status;
+    @JsonProperty("mode") @JsonInclude(JsonInclude.Include.NON_NULL)
+    private Mode mode;
+    @JsonProperty("power")
+    private Switch power;
+
+    public Account() {
+    }
+
+    public Account(Status status, Mode mode, Switch power) {
+        this.status = status;
+        this.mode = mode;
+        this.power = power;
+    }
+
+    public Status getStatus() {
+        return status;
+    }
+
+    public void setStatus(Status status) {
+        this.status = status;
+    }
+
+    public Mode getMode() {
+        return mode;
+    }
+
+    public void setMode(Mode mode) {
+        this.mode = mode;
+    }
+
+    public Switch getPower() {
+        return power;
+    }
+
+    public void setPower(Switch power) {
+        this.power = power;
+    }
+
+    @Override
+    public boolean equals(Object o) {
+        if (this == o) {
+            return true;
+        }
+        if (!(o instanceof Account other)) {
+            return false;
+        }
+        return Objects.equals(status, other.status)
+            && Objects.equals(mode, other.mode)
+            && Objects.equals(power, other.power);
+    }
+
+    @Override
+    public int hashCode() {
+        return Objects.hash(status, mode, power);
+    }
+}
@@ -0,0 +1,8 @@
+package acme.enums;
+
+public final class Flags {
+    public static final boolean ENABLED = true;
+
+    private Flags() {
+    }
+}
@@ -0,0 +1,9 @@
+package acme.enums;
+
+public final class Limits {
+    public static final long MIN = 0L;
+    public static final long MAX = 10L;
+
+    private Limits() {
+    }
+}
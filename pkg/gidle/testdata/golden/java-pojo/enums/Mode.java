@@ -0,0 +1,30 @@
+package acme.enums;
+
+import com.fasterxml.jackson.annotation.JsonCreator;
+import com.fasterxml.jackson.annotation.JsonValue;
+
+public enum Mode {
+    READ("read"),
+    WRITE("write");
+
+    private final String value;
+
+    Mode(String value) {
+        this.value = value;
+    }
+
+    @JsonValue
+    public String getValue() {
+        return value;
+    }
+
+    @JsonCreator(mode = JsonCreator.Mode.DELEGATING)
+    public static Mode fromValue(String value) {
+        for (Mode v : values()) {
+            if (v.value.equals(value)) {
+                return v;
+            }
+        }
+        throw new IllegalArgumentException("unknown Mode value " + value);
+    }
+}
@@ -0,0 +1,8 @@
+package acme.enums;
+
+public final class Names {
+    public static final String SERVICE = "acme";
+
+    private Names() {
+    }
+}
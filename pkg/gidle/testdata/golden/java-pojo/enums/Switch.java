@@ -0,0 +1,30 @@
+package acme.enums;
+
+import com.fasterxml.jackson.annotation.JsonCreator;
+import com.fasterxml.jackson.annotation.JsonValue;
+
+public enum Switch {
+    ON(true),
+    OFF(false);
+
+    private final boolean value;
+
+    Switch(boolean value) {
+        this.value = value;
+    }
+
+    @JsonValue
+    public boolean getValue() {
+        return value;
+    }
+
+    @JsonCreator(mode = JsonCreator.Mode.DELEGATING)
+    public static Switch fromValue(boolean value) {
+        for (Switch v : values()) {
+            if (v.value == value) {
+                return v;
+            }
+        }
+        throw new IllegalArgumentException("unknown Switch value " + value);
+    }
+}
@@ -0,0 +1,90 @@
+package acme.people;
+
+import com.fasterxml.jackson.annotation.JsonInclude;
+import com.fasterxml.jackson.annotation.JsonProperty;
+import java.util.List;
+import java.util.Objects;
+
+public class Person {
+    @JsonProperty("name")
+    private String name;
+    @JsonProperty("home")
+    private acme.common.Address home;
+    @JsonProperty("work") @JsonInclude(JsonInclude.Include.NON_NULL)
+    private acme.common.Address work;
+    @JsonProperty("kind")
+    private acme.common.Kind kind;
+    @JsonProperty("previous")
+    private List<acme.common.Address> previous;
+
+    public Person() {
+    }
+
+    public Person(String name, acme.common.Address home, acme.common.Address work, acme.common.Kind kind, List<acme.common.Address> previous) {
+        this.name = name;
+        this.home = home;
+        this.work = work;
+        this.kind = kind;
+        this.previous = previous;
+    }
+
+    public String getName() {
+        return name;
+    }
+
+    public void setName(String name) {
+        this.name = name;
+    }
+
+    public acme.common.Address getHome() {
+        return home;
+    }
+
+    public void setHome(acme.common.Address home) {
+        this.home = home;
+    }
+
+    public acme.common.Address getWork() {
+        return work;
+    }
+
+    public void setWork(acme.common.Address work) {
+        this.work = work;
+    }
+
+    public acme.common.Kind getKind() {
+        return kind;
+    }
+
+    public void setKind(acme.common.Kind kind) {
+        this.kind = kind;
+    }
+
+    public List<acme.common.Address> getPrevious() {
+        return previous;
+    }
+
+    public void setPrevious(List<acme.common.Address> previous) {
+        this.previous = previous;
+    }
+
+    @Override
+    public boolean equals(Object o) {
+        if (this == o) {
+            return true;
+        }
+        if (!(o instanceof Person other)) {
+            return false;
+        }
+        return Objects.equals(name, other.name)
+            && Objects.equals(home, other.home)
+            && Objects.equals(work, other.work)
+            && Objects.equals(kind, other.kind)
+            && Objects.equals(previous, other.previous);
+    }
+
+    @Override
+    public int hashCode() {
+        return Objects.hash(name, home, work, kind, previous);
+    }
+}
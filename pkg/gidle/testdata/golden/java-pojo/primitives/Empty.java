@@ -0,0 +1,24 @@
+package acme.models.v1;
+
+import java.util.Objects;
+
+public class Empty {
+    public Empty() {
+    }
+
+    @Override
+    public boolean equals(Object o) {
+        if (this == o) {
+            return true;
+        }
+        if (!(o instanceof Empty other)) {
+            return false;
+        }
+        return true;
+    }
+
+    @Override
+    public int hashCode() {
+        return Objects.hash();
+    }
+}
@@ -0,0 +1,103 @@
+package acme.models.v1;
+
+import com.fasterxml.jackson.annotation.JsonInclude;
+import com.fasterxml.jackson.annotation.JsonProperty;
+import java.util.List;
+import java.util.Map;
+import java.util.Objects;
+
+public class Optionals {
+    @JsonProperty("count") @JsonInclude(JsonInclude.Include.NON_NULL)
+    private Integer count;
+    @JsonProperty("label") @JsonInclude(JsonInclude.Include.NON_NULL)
+    private String label;
+    @JsonProperty("enabled") @JsonInclude(JsonInclude.Include.NON_NULL)
+    private Boolean enabled;
+    @JsonProperty("tags") @JsonInclude(JsonInclude.Include.NON_NULL)
+    private List<String> tags;
+    @JsonProperty("totals") @JsonInclude(JsonInclude.Include.NON_NULL)
+    private Map<String, Long> totals;
+    @JsonProperty("primitives") @JsonInclude(JsonInclude.Include.NON_NULL)
+    private Primitives primitives;
+
+    public Optionals() {
+    }
+
+    public Optionals(Integer count, String label, Boolean enabled, List<String> tags, Map<String, Long> totals, Primitives primitives) {
+        this.count = count;
+        this.label = label;
+        this.enabled = enabled;
+        this.tags = tags;
+        this.totals = totals;
+        this.primitives = primitives;
+    }
+
+    public Integer getCount() {
+        return count;
+    }
+
+    public void setCount(Integer count) {
+        this.count = count;
+    }
+
+    public String getLabel() {
+        return label;
+    }
+
+    public void setLabel(String label) {
+        this.label = label;
+    }
+
+    public Boolean getEnabled() {
+        return enabled;
+    }
+
+    public void setEnabled(Boolean enabled) {
+        this.enabled = enabled;
+    }
+
+    public List<String> getTags() {
+        return tags;
+    }
+
+    public void setTags(List<String> tags) {
+        this.tags = tags;
+    }
+
+    public Map<String, Long> getTotals() {
+        return totals;
+    }
+
+    public void setTotals(Map<String, Long> totals) {
+        this.totals = totals;
+    }
+
+    public Primitives getPrimitives() {
+        return primitives;
+    }
+
+    public void setPrimitives(Primitives primitives) {
+        this.primitives = primitives;
+    }
+
+    @Override
+    public boolean equals(Object o) {
+        if (this == o) {
+            return true;
+        }
+        if (!(o instanceof Optionals other)) {
+            return false;
+        }
+        return Objects.equals(count, other.count)
+            && Objects.equals(label, other.label)
+            && Objects.equals(enabled, other.enabled)
+            && Objects.equals(tags, other.tags)
+            && Objects.equals(totals, other.totals)
+            && Objects.equals(primitives, other.primitives);
+    }
+
+    @Override
+    public int hashCode() {
+        return Objects.hash(count, label, enabled, tags, totals, primitives);
+    }
+}
@@ -0,0 +1,173 @@
+package acme.models.v1;
+
+import com.fasterxml.jackson.annotation.JsonProperty;
+import java.math.BigInteger;
+import java.util.Objects;
+
+public class Primitives {
+    @JsonProperty("i8")
+    private byte i8;
+    @JsonProperty("i16")
+    private short i16;
+    @JsonProperty("i32")
+    private int i32;
+    @JsonProperty("i64")
+    private long i64;
+    @JsonProperty("u8")
+    private short u8;
+    @JsonProperty("u16")
+    private int u16;
+    @JsonProperty("u32")
+    private long u32;
+    @JsonProperty("u64")
+    private BigInteger u64;
+    @JsonProperty("f32")
+    private float f32;
+    @JsonProperty("f64")
+    private double f64;
+    @JsonProperty("flag")
+    private boolean flag;
+    @JsonProperty("text")
+    private String text;
+
+    public Primitives() {
+    }
+
+    public Primitives(byte i8, short i16, int i32, long i64, short u8, int u16, long u32, BigInteger u64, float f32, double f64, boolean flag, String text) {
+        this.i8 = i8;
+        this.i16 = i16;
+        this.i32 = i32;
+        this.i64 = i64;
+        this.u8 = u8;
+        this.u16 = u16;
+        this.u32 = u32;
+        this.u64 = u64;
+        this.f32 = f32;
+        this.f64 = f64;
+        this.flag = flag;
+        this.text = text;
+    }
+
+    public byte getI8() {
+        return i8;
+    }
+
+    public void setI8(byte i8) {
+        this.i8 = i8;
+    }
+
+    public short getI16() {
+        return i16;
+    }
+
+    public void setI16(short i16) {
+        this.i16 = i16;
+    }
+
+    public int getI32() {
+        return i32;
+    }
+
+    public void setI32(int i32) {
+        this.i32 = i32;
+    }
+
+    public long getI64() {
+        return i64;
+    }
+
+    public void setI64(long i64) {
+        this.i64 = i64;
+    }
+
+    public short getU8() {
+        return u8;
+    }
+
+    public void setU8(short u8) {
+        this.u8 = u8;
+    }
+
+    public int getU16() {
+        return u16;
+    }
+
+    public void setU16(int u16) {
+        this.u16 = u16;
+    }
+
+    public long getU32() {
+        return u32;
+    }
+
+    public void setU32(long u32) {
+        this.u32 = u32;
+    }
+
+    public BigInteger getU64() {
+        return u64;
+    }
+
+    public void setU64(BigInteger u64) {
+        this.u64 = u64;
+    }
+
+    public float getF32() {
+        return f32;
+    }
+
+    public void setF32(float f32) {
+        this.f32 = f32;
+    }
+
+    public double getF64() {
+        return f64;
+    }
+
+    public void setF64(double f64) {
+        this.f64 = f64;
+    }
+
+    public boolean getFlag() {
+        return flag;
+    }
+
+    public void setFlag(boolean flag) {
+        this.flag = flag;
+    }
+
+    public String getText() {
+        return text;
+    }
+
+    public void setText(String text) {
+        this.text = text;
+    }
+
+    @Override
+    public boolean equals(Object o) {
+        if (this == o) {
+            return true;
+        }
+        if (!(o instanceof Primitives other)) {
+            return false;
+        }
+        return Objects.equals(i8, other.i8)
+            && Objects.equals(i16, other.i16)
+            && Objects.equals(i32, other.i32)
+            && Objects.equals(i64, other.i64)
+            && Objects.equals(u8, other.u8)
+            && Objects.equals(u16, other.u16)
+            && Objects.equals(u32, other.u32)
+            && Objects.equals(u64, other.u64)
+            && Objects.equals(f32, other.f32)
+            && Objects.equals(f64, other.f64)
+            && Objects.equals(flag, other.flag)
+            && Objects.equals(text, other.text);
+    }
+
+    @Override
+    public int hashCode() {
+        return Objects.hash(i8, i16, i32, i64, u8, u16, u32, u64, f32, f64, flag, text);
+    }
+}
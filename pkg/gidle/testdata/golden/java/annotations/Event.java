@@ -0,0 +1,17 @@
+package acme.annotations;
+
+import com.fasterxml.jackson.annotation.JsonInclude;
+import com.fasterxml.jackson.annotation.JsonProperty;
+
+/**
+ * An event from a third party.
+ *
+ * @param typeName Type of the event.
+ * @param channel Delivery channel.
+ */
+public record Event(
+        @JsonProperty("@type") String typeName,
+        @JsonProperty("user-id") @JsonInclude(JsonInclude.Include.NON_NULL) String userId,
+        @JsonProperty("channel") Channel channel,
+        @JsonProperty("created_at") long createdAt) {
+}
@@ -0,0 +1,8 @@
+package acme.collections;
+
+import com.fasterxml.jackson.annotation.JsonProperty;
+
+public record Point(
+        @JsonProperty("x") double x,
+        @JsonProperty("y") double y) {
+}
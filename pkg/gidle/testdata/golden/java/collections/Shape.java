@@ -0,0 +1,18 @@
+package acme.collections;
+
+import com.fasterxml.jackson.annotation.JsonInclude;
+import com.fasterxml.jackson.annotation.JsonProperty;
+import java.util.List;
+import java.util.Map;
+
+public record Shape(
+        @JsonProperty("points") List<Point> points,
+        @JsonProperty("grid") List<List<Integer>> grid,
+        @JsonProperty("named") Map<String, Point> named,
+        @JsonProperty("palette") Map<Integer, List<Color>> palette,
+        @JsonProperty("weights") List<Map<String, Float>> weights,
+        @JsonProperty("flags") Map<Long, Map<String, Boolean>> flags,
+        @JsonProperty("counts") List<Map<String, Integer>> counts,
+        @JsonProperty("aliases") Map<String, List<String>> aliases,
+        @JsonProperty("colors") @JsonInclude(JsonInclude.Include.NON_NULL) List<Color> colors) {
+}
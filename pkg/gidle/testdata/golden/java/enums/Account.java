@@ -0,0 +1,10 @@
+package acme.enums;
+
+import com.fasterxml.jackson.annotation.JsonInclude;
+import com.fasterxml.jackson.annotation.JsonProperty;
+
+public record Account(
+        @JsonProperty("status") Status status,
+        @JsonProperty("mode") @JsonInclude(JsonInclude.Include.NON_NULL) Mode mode,
+        @JsonProperty("power") Switch power) {
+}
@@ -0,0 +1,9 @@
+package acme.enums;
+
+public final class Ratios {
+    public static final float HALF = 0.5f;
+    public static final float ONE = 1f;
+
+    private Ratios() {
+    }
+}
@@ -0,0 +1,31 @@
+package acme.enums;
+
+import com.fasterxml.jackson.annotation.JsonCreator;
+import com.fasterxml.jackson.annotation.JsonValue;
+import java.math.BigInteger;
+
+public enum Size {
+    SMALL(new BigInteger("1")),
+    LARGE(new BigInteger("1000000"));
+
+    private final BigInteger value;
+
+    Size(BigInteger value) {
+        this.value = value;
+    }
+
+    @JsonValue
+    public BigInteger getValue() {
+        return value;
+    }
+
+    @JsonCreator(mode = JsonCreator.Mode.DELEGATING)
+    public static Size fromValue(BigInteger value) {
+        for (Size v : values()) {
+            if (v.value.equals(value)) {
+                return v;
+            }
+        }
+        throw new IllegalArgumentException("unknown Size value " + value);
+    }
+}
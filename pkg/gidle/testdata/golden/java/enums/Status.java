@@ -0,0 +1,31 @@
+package acme.enums;
+
+import com.fasterxml.jackson.annotation.JsonCreator;
+import com.fasterxml.jackson.annotation.JsonValue;
+
+public enum Status {
+    UNKNOWN(0),
+    ACTIVE(1),
+    DISABLED(2);
+
+    private final int value;
+
+    Status(int value) {
+        this.value = value;
+    }
+
+    @JsonValue
+    public int getValue() {
+        return value;
+    }
+
+    @JsonCreator(mode = JsonCreator.Mode.DELEGATING)
+    public static Status fromValue(int value) {
+        for (Status v : values()) {
+            if (v.value == value) {
+                return v;
+            }
+        }
+        throw new IllegalArgumentException("unknown Status value " + value);
+    }
+}
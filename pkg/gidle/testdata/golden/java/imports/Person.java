@@ -0,0 +1,13 @@
+package acme.people;
+
+import com.fasterxml.jackson.annotation.JsonInclude;
+import com.fasterxml.jackson.annotation.JsonProperty;
+import java.util.List;
+
+public record Person(
+        @JsonProperty("name") String name,
+        @JsonProperty("home") acme.common.Address home,
+        @JsonProperty("work") @JsonInclude(JsonInclude.Include.NON_NULL) acme.common.Address work,
+        @JsonProperty("kind") acme.common.Kind kind,
+        @JsonProperty("previous") List<acme.common.Address> previous) {
+}
@@ -0,0 +1,4 @@
+package acme.models.v1;
+
+public record Empty() {
+}
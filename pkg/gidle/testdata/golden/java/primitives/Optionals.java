@@ -0,0 +1,15 @@
+package acme.models.v1;
+
+import com.fasterxml.jackson.annotation.JsonInclude;
+import com.fasterxml.jackson.annotation.JsonProperty;
+import java.util.List;
+import java.util.Map;
+
+public record Optionals(
+        @JsonProperty("count") @JsonInclude(JsonInclude.Include.NON_NULL) Integer count,
+        @JsonProperty("label") @JsonInclude(JsonInclude.Include.NON_NULL) String label,
+        @JsonProperty("enabled") @JsonInclude(JsonInclude.Include.NON_NULL) Boolean enabled,
+        @JsonProperty("tags") @JsonInclude(JsonInclude.Include.NON_NULL) List<String> tags,
+        @JsonProperty("totals") @JsonInclude(JsonInclude.Include.NON_NULL) Map<String, Long> totals,
+        @JsonProperty("primitives") @JsonInclude(JsonInclude.Include.NON_NULL) Primitives primitives) {
+}
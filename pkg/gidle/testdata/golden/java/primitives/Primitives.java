@@ -0,0 +1,19 @@
+package acme.models.v1;
+
+import com.fasterxml.jackson.annotation.JsonProperty;
+import java.math.BigInteger;
+
+public record Primitives(
+        @JsonProperty("i8") byte i8,
+        @JsonProperty("i16") short i16,
+        @JsonProperty("i32") int i32,
+        @JsonProperty("i64") long i64,
+        @JsonProperty("u8") short u8,
+        @JsonProperty("u16") int u16,
+        @JsonProperty("u32") long u32,
+        @JsonProperty("u64") BigInteger u64,
+        @JsonProperty("f32") float f32,
+        @JsonProperty("f64") double f64,
+        @JsonProperty("flag") boolean flag,
+        @JsonProperty("text") String text) {
+}
@@ -0,0 +1,74 @@
+package acme.annotations
+
+import kotlinx.serialization.KSerializer
+import kotlinx.serialization.SerialName
+import kotlinx.serialization.Serializable
+import kotlinx.serialization.descriptors.PrimitiveKind
+import kotlinx.serialization.descriptors.PrimitiveSerialDescriptor
+import kotlinx.serialization.descriptors.SerialDescriptor
+import kotlinx.serialization.encoding.Decoder
+import kotlinx.serialization.encoding.Encoder
+
+/**
+ * How an event was delivered.
+ */
+@Serializable(with = ChannelSerializer::class)
+enum class Channel(val value: Int) {
+    /**
+     * Not set.
+     */
+    NONE(0),
+    /**
+     * Sent by email.
+     */
+    EMAIL(1);
+
+    companion object {
+        fun fromValue(value: Int): Channel =
+            values().firstOrNull { it.value == value }
+                ?: throw IllegalArgumentException("unknown Channel value $value")
+    }
+}
+
+object ChannelSerializer : KSerializer<Channel> {
+    override val descriptor: SerialDescriptor =
+        PrimitiveSerialDescriptor("acme.annotations.Channel", PrimitiveKind.INT)
+
+    override fun serialize(encoder: Encoder, value: Channel) =
+        encoder.encodeInt(value.value)
+
+    override fun deserialize(decoder: Decoder): Channel =
+        Channel.fromValue(decoder.decodeInt())
+}
+
+/**
+ * Retry settings.
+ */
+object Retry {
+    /**
+     * Attempts before giving up.
+     */
+    const val ATTEMPTS: Int = 3
+}
+
+/**
+ * An event from a third party.
+ */
+@Serializable
+data class Event(
+    /**
+     * Type of the event.
+     */
+    @SerialName("@type")
+    val typeName: String,
+    @SerialName("user-id")
+    val userId: String? = null,
+    /**
+     * Delivery channel.
+     */
+    @SerialName("channel")
+    val channel: Channel,
+    @SerialName("created_at")
+    val createdAt: Long,
+)
+
@@ -0,0 +1,64 @@
+package acme.collections
+
+import kotlinx.serialization.KSerializer
+import kotlinx.serialization.SerialName
+import kotlinx.serialization.Serializable
+import kotlinx.serialization.descriptors.PrimitiveKind
+import kotlinx.serialization.descriptors.PrimitiveSerialDescriptor
+import kotlinx.serialization.descriptors.SerialDescriptor
+import kotlinx.serialization.encoding.Decoder
+import kotlinx.serialization.encoding.Encoder
+
+@Serializable(with = ColorSerializer::class)
+enum class Color(val value: UByte) {
+    RED(0u),
+    GREEN(1u);
+
+    companion object {
+        fun fromValue(value: UByte): Color =
+            values().firstOrNull { it.value == value }
+                ?: throw IllegalArgumentException("unknown Color value $value")
+    }
+}
+
+object ColorSerializer : KSerializer<Color> {
+    override val descriptor: SerialDescriptor =
+        PrimitiveSerialDescriptor("acme.collections.Color", PrimitiveKind.SHORT)
+
+    override fun serialize(encoder: Encoder, value: Color) =
+        encoder.encodeShort(value.value.toShort())
+
+    override fun deserialize(decoder: Decoder): Color =
+        Color.fromValue(decoder.decodeShort().toUByte())
+}
+
+@Serializable
+data class Point(
+    @SerialName("x")
+    val x: Double,
+    @SerialName("y")
+    val y: Double,
+)
+
+@Serializable
+data class Shape(
+    @SerialName("points")
+    val points: List<Point>,
+    @SerialName("grid")
+    val grid: List<List<Int>>,
+    @SerialName("named")
+    val named: Map<String, Point>,
+    @SerialName("palette")
+    val palette: Map<UShort, List<Color>>,
+    @SerialName("weights")
+    val weights: List<Map<String, Float>>,
+    @SerialName("flags")
+    val flags: Map<Long, Map<String, Boolean>>,
+    @SerialName("counts")
+    val counts: List<Map<String, Int>>,
+    @SerialName("aliases")
+    val aliases: Map<String, List<String>>,
+    @SerialName("colors")
+    val colors: List<Color>? = null,
+)
+
@@ -0,0 +1,132 @@
+package acme.enums
+
+import kotlinx.serialization.KSerializer
+import kotlinx.serialization.SerialName
+import kotlinx.serialization.Serializable
+import kotlinx.serialization.descriptors.PrimitiveKind
+import kotlinx.serialization.descriptors.PrimitiveSerialDescriptor
+import kotlinx.serialization.descriptors.SerialDescriptor
+import kotlinx.serialization.encoding.Decoder
+import kotlinx.serialization.encoding.Encoder
+
+@Serializable(with = StatusSerializer::class)
+enum class Status(val value: Int) {
+    UNKNOWN(0),
+    ACTIVE(1),
+    DISABLED(2);
+
+    companion object {
+        fun fromValue(value: Int): Status =
+            values().firstOrNull { it.value == value }
+                ?: throw IllegalArgumentException("unknown Status value $value")
+    }
+}
+
+object StatusSerializer : KSerializer<Status> {
+    override val descriptor: SerialDescriptor =
+        PrimitiveSerialDescriptor("acme.enums.Status", PrimitiveKind.INT)
+
+    override fun serialize(encoder: Encoder, value: Status) =
+        encoder.encodeInt(value.value)
+
+    override fun deserialize(decoder: Decoder): Status =
+        Status.fromValue(decoder.decodeInt())
+}
+
+@Serializable(with = SizeSerializer::class)
+enum class Size(val value: ULong) {
+    SMALL(1uL),
+    LARGE(1000000uL);
+
+    companion object {
+        fun fromValue(value: ULong): Size =
+            values().firstOrNull { it.value == value }
+                ?: throw IllegalArgumentException("unknown Size value $value")
+    }
+}
+
+object SizeSerializer : KSerializer<Size> {
+    override val descriptor: SerialDescriptor =
+        PrimitiveSerialDescriptor("acme.enums.Size", PrimitiveKind.LONG)
+
+    override fun serialize(encoder: Encoder, value: Size) =
+        encoder.encodeLong(value.value.toLong())
+
+    override fun deserialize(decoder: Decoder): Size =
+        Size.fromValue(decoder.decodeLong().toULong())
+}
+
+@Serializable(with = ModeSerializer::class)
+enum class Mode(val value: String) {
+    READ("read"),
+    WRITE("write");
+
+    companion object {
+        fun fromValue(value: String): Mode =
+            values().firstOrNull { it.value == value }
+                ?: throw IllegalArgumentException("unknown Mode value $value")
+    }
+}
+
+object ModeSerializer : KSerializer<Mode> {
+    override val descriptor: SerialDescriptor =
+        PrimitiveSerialDescriptor("acme.enums.Mode", PrimitiveKind.STRING)
+
+    override fun serialize(encoder: Encoder, value: Mode) =
+        encoder.encodeString(value.value)
+
+    override fun deserialize(decoder: Decoder): Mode =
+        Mode.fromValue(decoder.decodeString())
+}
+
+@Serializable(with = SwitchSerializer::class)
+enum class Switch(val value: Boolean) {
+    ON(true),
+    OFF(false);
+
+    companion object {
+        fun fromValue(value: Boolean): Switch =
+            values().firstOrNull { it.value == value }
+                ?: throw IllegalArgumentException("unknown Switch value $value")
+    }
+}
+
+object SwitchSerializer : KSerializer<Switch> {
+    override val descriptor: SerialDescriptor =
+        PrimitiveSerialDescriptor("acme.enums.Switch", PrimitiveKind.BOOLEAN)
+
+    override fun serialize(encoder: Encoder, value: Switch) =
+        encoder.encodeBoolean(value.value)
+
+    override fun deserialize(decoder: Decoder): Switch =
+        Switch.fromValue(decoder.decodeBoolean())
+}
+
+object Limits {
+    const val MIN: Long = 0L
+    const val MAX: Long = 10L
+}
+
+object Ratios {
+    const val HALF: Float = 0.5f
+    const val ONE: Float = 1f
+}
+
+object Names {
+    const val SERVICE: String = "acme"
+}
+
+object Flags {
+    const val ENABLED: Boolean = true
+}
+
+@Serializable
+data class Account(
+    @SerialName("status")
+    val status: Status,
+    @SerialName("mode")
+    val mode: Mode? = null,
+    @SerialName("power")
+    val power: Switch,
+)
+
@@ -0,0 +1,19 @@
+package acme.people
+
+import kotlinx.serialization.SerialName
+import kotlinx.serialization.Serializable
+
+@Serializable
+data class Person(
+    @SerialName("name")
+    val name: String,
+    @SerialName("home")
+    val home: acme.common.Address,
+    @SerialName("work")
+    val work: acme.common.Address? = null,
+    @SerialName("kind")
+    val kind: acme.common.Kind,
+    @SerialName("previous")
+    val previous: List<acme.common.Address>,
+)
+
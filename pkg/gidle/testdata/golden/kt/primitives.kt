@@ -0,0 +1,53 @@
+package acme.models.v1
+
+import kotlinx.serialization.SerialName
+import kotlinx.serialization.Serializable
+
+@Serializable
+data class Primitives(
+    @SerialName("i8")
+    val i8: Byte,
+    @SerialName("i16")
+    val i16: Short,
+    @SerialName("i32")
+    val i32: Int,
+    @SerialName("i64")
+    val i64: Long,
+    @SerialName("u8")
+    val u8: UByte,
+    @SerialName("u16")
+    val u16: UShort,
+    @SerialName("u32")
+    val u32: UInt,
+    @SerialName("u64")
+    val u64: ULong,
+    @SerialName("f32")
+    val f32: Float,
+    @SerialName("f64")
+    val f64: Double,
+    @SerialName("flag")
+    val flag: Boolean,
+    @SerialName("text")
+    val text: String,
+)
+
+@Serializable
+data class Optionals(
+    @SerialName("count")
+    val count: Int? = null,
+    @SerialName("label")
+    val label: String? = null,
+    @SerialName("enabled")
+    val enabled: Boolean? = null,
+    @SerialName("tags")
+    val tags: List<String>? = null,
+    @SerialName("totals")
+    val totals: Map<String, Long>? = null,
+    @SerialName("primitives")
+    val primitives: Primitives? = null,
+)
+
+@Serializable
+data class Empty(
+)
+
@@ -0,0 +1,58 @@
+# Package acme.annotations
+from __future__ import annotations
+
+import json
+from dataclasses import dataclass
+from enum import Enum, IntEnum
+from typing import Any, Dict, List, Optional
+
+
+class Channel(IntEnum):
+    """How an event was delivered."""
+    #: Not set.
+    NONE = 0
+    #: Sent by email.
+    EMAIL = 1
+
+
+#: Retry settings.
+#:
+#: Attempts before giving up.
+Retry_ATTEMPTS: int = 3
+
+
+@dataclass(kw_only=True)
+class Event:
+    """An event from a third party."""
+    #: Type of the event.
+    type_name: str
+    user_id: Optional[str] = None
+    #: Delivery channel.
+    channel: Channel
+    created_at: int
+
+    @classmethod
+    def from_dict(cls, data: Dict[str, Any]) -> Event:
+        return cls(
+            type_name=data["@type"],
+            user_id=data.get("user-id") if data.get("user-id") is not None else None,
+            channel=Channel(data["channel"]),
+            created_at=data["created_at"],
+        )
+
+    def to_dict(self) -> Dict[str, Any]:
+        return {
+            "@type": self.type_name,
+            "user-id": self.user_id,
+            "channel": self.channel.value,
+            "created_at": self.created_at,
+        }
+
+    @classmethod
+    def from_json(cls, source: str) -> Event:
+        return cls.from_dict(json.loads(source))
+
+    def to_json(self) -> str:
+        return json.dumps(self.to_dict())
+
+
@@ -0,0 +1,87 @@
+# Package acme.collections
+from __future__ import annotations
+
+import json
+from dataclasses import dataclass
+from enum import Enum, IntEnum
+from typing import Any, Dict, List, Optional
+
+
+class Color(IntEnum):
+    RED = 0
+    GREEN = 1
+
+
+@dataclass(kw_only=True)
+class Point:
+    x: float
+    y: float
+
+    @classmethod
+    def from_dict(cls, data: Dict[str, Any]) -> Point:
+        return cls(
+            x=float(data["x"]),
+            y=float(data["y"]),
+        )
+
+    def to_dict(self) -> Dict[str, Any]:
+        return {
+            "x": self.x,
+            "y": self.y,
+        }
+
+    @classmethod
+    def from_json(cls, source: str) -> Point:
+        return cls.from_dict(json.loads(source))
+
+    def to_json(self) -> str:
+        return json.dumps(self.to_dict())
+
+
+@dataclass(kw_only=True)
+class Shape:
+    points: List[Point]
+    grid: List[List[int]]
+    named: Dict[str, Point]
+    palette: Dict[int, List[Color]]
+    weights: List[Dict[str, float]]
+    flags: Dict[int, Dict[str, bool]]
+    counts: List[Dict[str, int]]
+    aliases: Dict[str, List[str]]
+    colors: Optional[List[Color]] = None
+
+    @classmethod
+    def from_dict(cls, data: Dict[str, Any]) -> Shape:
+        return cls(
+            points=[Point.from_dict(e0) for e0 in data["points"]],
+            grid=[[e1 for e1 in e0] for e0 in data["grid"]],
+            named={k0: Point.from_dict(e0) for k0, e0 in data["named"].items()},
+            palette={int(k0): [Color(e1) for e1 in e0] for k0, e0 in data["palette"].items()},
+            weights=[{k1: float(e1) for k1, e1 in e0.items()} for e0 in data["weights"]],
+            flags={int(k0): {k1: e1 for k1, e1 in e0.items()} for k0, e0 in data["flags"].items()},
+            counts=[{k1: e1 for k1, e1 in e0.items()} for e0 in data["counts"]],
+            aliases={k0: [e1 for e1 in e0] for k0, e0 in data["aliases"].items()},
+            colors=[Color(e0) for e0 in data.get("colors")] if data.get("colors") is not None else None,
+        )
+
+    def to_dict(self) -> Dict[str, Any]:
+        return {
+            "points": [e0.to_dict() for e0 in self.points],
+            "grid": self.grid,
+            "named": {str(k0): e0.to_dict() for k0, e0 in self.named.items()},
+            "palette": {str(k0): [e1.value for e1 in e0] for k0, e0 in self.palette.items()},
+            "weights": self.weights,
+            "flags": {str(k0): e0 for k0, e0 in self.flags.items()},
+            "counts": self.counts,
+            "aliases": self.aliases,
+            "colors": [e0.value for e0 in self.colors] if self.colors is not None else None,
+        }
+
+    @classmethod
+    def from_json(cls, source: str) -> Shape:
+        return cls.from_dict(json.loads(source))
+
+    def to_json(self) -> str:
+        return json.dumps(self.to_dict())
+
+
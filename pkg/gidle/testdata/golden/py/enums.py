@@ -0,0 +1,73 @@
+# Package acme.enums
+from __future__ import annotations
+
+import json
+from dataclasses import dataclass
+from enum import Enum, IntEnum
+from typing import Any, Dict, List, Optional
+
+
+class Status(IntEnum):
+    UNKNOWN = 0
+    ACTIVE = 1
+    DISABLED = 2
+
+
+class Size(IntEnum):
+    SMALL = 1
+    LARGE = 1000000
+
+
+class Mode(str, Enum):
+    READ = "read"
+    WRITE = "write"
+
+
+class Switch(Enum):
+    ON = True
+    OFF = False
+
+
+Limits_MIN: int = 0
+Limits_MAX: int = 10
+
+
+Ratios_HALF: float = 0.5
+Ratios_ONE: float = 1
+
+
+Names_SERVICE: str = "acme"
+
+
+Flags_ENABLED: bool = True
+
+
+@dataclass(kw_only=True)
+class Account:
+    status: Status
+    mode: Optional[Mode] = None
+    power: Switch
+
+    @classmethod
+    def from_dict(cls, data: Dict[str, Any]) -> Account:
+        return cls(
+            status=Status(data["status"]),
+            mode=Mode(data.get("mode")) if data.get("mode") is not None else None,
+            power=Switch(data["power"]),
+        )
+
+    def to_dict(self) -> Dict[str, Any]:
+        return {
+            "status": self.status.value,
+            "mode": self.mode.value if self.mode is not None else None,
+            "power": self.power.value,
+        }
+
+    @classmethod
+    def from_json(cls, source: str) -> Account:
+        return cls.from_dict(json.loads(source))
+
+    def to_json(self) -> str:
+        return json.dumps(self.to_dict())
+
+
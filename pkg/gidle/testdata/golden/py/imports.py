@@ -0,0 +1,46 @@
+# Package acme.people
+from __future__ import annotations
+
+import json
+from dataclasses import dataclass
+from enum import Enum, IntEnum
+from typing import Any, Dict, List, Optional
+from acme.common import types as common
+from acme.common import types as shared
+
+
+@dataclass(kw_only=True)
+class Person:
+    name: str
+    home: common.Address
+    work: Optional[shared.Address] = None
+    kind: common.Kind
+    previous: List[common.Address]
+
+    @classmethod
+    def from_dict(cls, data: Dict[str, Any]) -> Person:
+        return cls(
+            name=data["name"],
+            home=common.Address.from_dict(data["home"]),
+            work=shared.Address.from_dict(data.get("work")) if data.get("work") is not None else None,
+            kind=common.Kind(data["kind"]),
+            previous=[common.Address.from_dict(e0) for e0 in data["previous"]],
+        )
+
+    def to_dict(self) -> Dict[str, Any]:
+        return {
+            "name": self.name,
+            "home": self.home.to_dict(),
+            "work": self.work.to_dict() if self.work is not None else None,
+            "kind": self.kind.value,
+            "previous": [e0.to_dict() for e0 in self.previous],
+        }
+
+    @classmethod
+    def from_json(cls, source: str) -> Person:
+        return cls.from_dict(json.loads(source))
+
+    def to_json(self) -> str:
+        return json.dumps(self.to_dict())
+
+
@@ -0,0 +1,122 @@
+# Package acme.models.v1
+from __future__ import annotations
+
+import json
+from dataclasses import dataclass
+from enum import Enum, IntEnum
+from typing import Any, Dict, List, Optional
+
+
+@dataclass(kw_only=True)
+class Primitives:
+    i8: int
+    i16: int
+    i32: int
+    i64: int
+    u8: int
+    u16: int
+    u32: int
+    u64: int
+    f32: float
+    f64: float
+    flag: bool
+    text: str
+
+    @classmethod
+    def from_dict(cls, data: Dict[str, Any]) -> Primitives:
+        return cls(
+            i8=data["i8"],
+            i16=data["i16"],
+            i32=data["i32"],
+            i64=data["i64"],
+            u8=data["u8"],
+            u16=data["u16"],
+            u32=data["u32"],
+            u64=data["u64"],
+            f32=float(data["f32"]),
+            f64=float(data["f64"]),
+            flag=data["flag"],
+            text=data["text"],
+        )
+
+    def to_dict(self) -> Dict[str, Any]:
+        return {
+            "i8": self.i8,
+            "i16": self.i16,
+            "i32": self.i32,
+            "i64": self.i64,
+            "u8": self.u8,
+            "u16": self.u16,
+            "u32": self.u32,
+            "u64": self.u64,
+            "f32": self.f32,
+            "f64": self.f64,
+            "flag": self.flag,
+            "text": self.text,
+        }
+
+    @classmethod
+    def from_json(cls, source: str) -> Primitives:
+        return cls.from_dict(json.loads(source))
+
+    def to_json(self) -> str:
+        return json.dumps(self.to_dict())
+
+
+@dataclass(kw_only=True)
+class Optionals:
+    count: Optional[int] = None
+    label: Optional[str] = None
+    enabled: Optional[bool] = None
+    tags: Optional[List[str]] = None
+    totals: Optional[Dict[str, int]] = None
+    primitives: Optional[Primitives] = None
+
+    @classmethod
+    def from_dict(cls, data: Dict[str, Any]) -> Optionals:
+        return cls(
+            count=data.get("count") if data.get("count") is not None else None,
+            label=data.get("label") if data.get("label") is not None else None,
+            enabled=data.get("enabled") if data.get("enabled") is not None else None,
+            tags=[e0 for e0 in data.get("tags")] if data.get("tags") is not None else None,
+            totals={k0: e0 for k0, e0 in data.get("totals").items()} if data.get("totals") is not None else None,
+            primitives=Primitives.from_dict(data.get("primitives")) if data.get("primitives") is not None else None,
+        )
+
+    def to_dict(self) -> Dict[str, Any]:
+        return {
+            "count": self.count,
+            "label": self.label,
+            "enabled": self.enabled,
+            "tags": self.tags,
+            "totals": self.totals,
+            "primitives": self.primitives.to_dict() if self.primitives is not None else None,
+        }
+
+    @classmethod
+    def from_json(cls, source: str) -> Optionals:
+        return cls.from_dict(json.loads(source))
+
+    def to_json(self) -> str:
+        return json.dumps(self.to_dict())
+
+
+@dataclass(kw_only=True)
+class Empty:
+    @classmethod
+    def from_dict(cls, data: Dict[str, Any]) -> Empty:
+        return cls(
+        )
+
+    def to_dict(self) -> Dict[str, Any]:
+        return {
+        }
+
+    @classmethod
+    def from_json(cls, source: str) -> Empty:
+        return cls.from_dict(json.loads(source))
+
+    def to_json(self) -> str:
+        return json.dumps(self.to_dict())
+
+
@@ -0,0 +1,46 @@
+use std::collections::HashMap;
+use serde::{Deserialize, Serialize};
+use serde_json::{to_string, from_str, Result};
+
+/// How an event was delivered.
+pub enum Channel {
+	/// Not set.
+	NONE = 0,
+	/// Sent by email.
+	EMAIL = 1,
+}
+
+// Retry settings.
+/// Attempts before giving up.
+pub const Retry_ATTEMPTS: i32 = 3;
+/// An event from a third party.
+#[derive(Debug, Serialize, Deserialize)]
+pub struct Event {
+	/// Type of the event.
+	#[serde(rename = "@type")]
+	pub type_name: String,
+	#[serde(default, skip_serializing_if = "Option::is_none")]
+	#[serde(rename = "user-id")]
+	pub user_id: Option<String>,
+	/// Delivery channel.
+	pub channel: Channel,
+	pub created_at: i64,
+}
+
+impl Event {
+	pub fn new(type_name: String, user_id: Option<String>, channel: Channel, created_at: i64) -> Self {
+		Self {
+			type_name,
+			user_id,
+			channel,
+			created_at,
+		}
+	}
+	pub fn to_json(&self) -> Result<String> {
+		to_string(self)
+	}
+	pub fn from_json(json: &str) -> Result<Self> {
+		from_str(json)
+	}
+}
+
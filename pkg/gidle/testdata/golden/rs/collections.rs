@@ -0,0 +1,66 @@
+use std::collections::HashMap;
+use serde::{Deserialize, Serialize};
+use serde_json::{to_string, from_str, Result};
+
+pub enum Color {
+	RED = 0,
+	GREEN = 1,
+}
+
+#[derive(Debug, Serialize, Deserialize)]
+pub struct Point {
+	pub x: f64,
+	pub y: f64,
+}
+
+impl Point {
+	pub fn new(x: f64, y: f64) -> Self {
+		Self {
+			x,
+			y,
+		}
+	}
+	pub fn to_json(&self) -> Result<String> {
+		to_string(self)
+	}
+	pub fn from_json(json: &str) -> Result<Self> {
+		from_str(json)
+	}
+}
+
+#[derive(Debug, Serialize, Deserialize)]
+pub struct Shape {
+	pub points: Vec<Point>,
+	pub grid: Vec<Vec<i32>>,
+	pub named: HashMap<String, Point>,
+	pub palette: HashMap<u16, Vec<Color>>,
+	pub weights: Vec<HashMap<String, f32>>,
+	pub flags: HashMap<i64, HashMap<String, bool>>,
+	pub counts: Vec<HashMap<String, i32>>,
+	pub aliases: HashMap<String, Vec<String>>,
+	#[serde(default, skip_serializing_if = "Option::is_none")]
+	pub colors: Option<Vec<Color>>,
+}
+
+impl Shape {
+	pub fn new(points: Vec<Point>, grid: Vec<Vec<i32>>, named: HashMap<String, Point>, palette: HashMap<u16, Vec<Color>>, weights: Vec<HashMap<String, f32>>, flags: HashMap<i64, HashMap<String, bool>>, counts: Vec<HashMap<String, i32>>, aliases: HashMap<String, Vec<String>>, colors: Option<Vec<Color>>) -> Self {
+		Self {
+			points,
+			grid,
+			named,
+			palette,
+			weights,
+			flags,
+			counts,
+			aliases,
+			colors,
+		}
+	}
+	pub fn to_json(&self) -> Result<String> {
+		to_string(self)
+	}
+	pub fn from_json(json: &str) -> Result<Self> {
+		from_str(json)
+	}
+}
+
@@ -0,0 +1,55 @@
+use std::collections::HashMap;
+use serde::{Deserialize, Serialize};
+use serde_json::{to_string, from_str, Result};
+
+pub enum Status {
+	UNKNOWN = 0,
+	ACTIVE = 1,
+	DISABLED = 2,
+}
+
+pub enum Size {
+	SMALL = 1,
+	LARGE = 1000000,
+}
+
+pub enum Mode {
+	READ = "read",
+	WRITE = "write",
+}
+
+pub enum Switch {
+	ON = true,
+	OFF = false,
+}
+
+pub const Limits_MIN: i64 = 0;
+pub const Limits_MAX: i64 = 10;
+pub const Ratios_HALF: f32 = 0.5;
+pub const Ratios_ONE: f32 = 1;
+pub const Names_SERVICE: String = "acme";
+pub const Flags_ENABLED: bool = true;
+#[derive(Debug, Serialize, Deserialize)]
+pub struct Account {
+	pub status: Status,
+	#[serde(default, skip_serializing_if = "Option::is_none")]
+	pub mode: Option<Mode>,
+	pub power: Switch,
+}
+
+impl Account {
+	pub fn new(status: Status, mode: Option<Mode>, power: Switch) -> Self {
+		Self {
+			status,
+			mode,
+			power,
+		}
+	}
+	pub fn to_json(&self) -> Result<String> {
+		to_string(self)
+	}
+	pub fn from_json(json: &str) -> Result<Self> {
+		from_str(json)
+	}
+}
+
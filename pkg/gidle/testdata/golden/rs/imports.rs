@@ -0,0 +1,34 @@
+use std::collections::HashMap;
+use serde::{Deserialize, Serialize};
+use serde_json::{to_string, from_str, Result};
+use crate::acme::common;
+use crate::acme::common as shared;
+
+#[derive(Debug, Serialize, Deserialize)]
+pub struct Person {
+	pub name: String,
+	pub home: common::Address,
+	#[serde(default, skip_serializing_if = "Option::is_none")]
+	pub work: Option<shared::Address>,
+	pub kind: common::Kind,
+	pub previous: Vec<common::Address>,
+}
+
+impl Person {
+	pub fn new(name: String, home: common::Address, work: Option<shared::Address>, kind: common::Kind, previous: Vec<common::Address>) -> Self {
+		Self {
+			name,
+			home,
+			work,
+			kind,
+			previous,
+		}
+	}
+	pub fn to_json(&self) -> Result<String> {
+		to_string(self)
+	}
+	pub fn from_json(json: &str) -> Result<Self> {
+		from_str(json)
+	}
+}
+
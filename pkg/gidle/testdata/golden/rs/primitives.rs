@@ -0,0 +1,97 @@
+use std::collections::HashMap;
+use serde::{Deserialize, Serialize};
+use serde_json::{to_string, from_str, Result};
+
+#[derive(Debug, Serialize, Deserialize)]
+pub struct Primitives {
+	pub i8: i8,
+	pub i16: i16,
+	pub i32: i32,
+	pub i64: i64,
+	pub u8: u8,
+	pub u16: u16,
+	pub u32: u32,
+	pub u64: u64,
+	pub f32: f32,
+	pub f64: f64,
+	pub flag: bool,
+	pub text: String,
+}
+
+impl Primitives {
+	pub fn new(i8: i8, i16: i16, i32: i32, i64: i64, u8: u8, u16: u16, u32: u32, u64: u64, f32: f32, f64: f64, flag: bool, text: String) -> Self {
+		Self {
+			i8,
+			i16,
+			i32,
+			i64,
+			u8,
+			u16,
+			u32,
+			u64,
+			f32,
+			f64,
+			flag,
+			text,
+		}
+	}
+	pub fn to_json(&self) -> Result<String> {
+		to_string(self)
+	}
+	pub fn from_json(json: &str) -> Result<Self> {
+		from_str(json)
+	}
+}
+
+#[derive(Debug, Serialize, Deserialize)]
+pub struct Optionals {
+	#[serde(default, skip_serializing_if = "Option::is_none")]
+	pub count: Option<i32>,
+	#[serde(default, skip_serializing_if = "Option::is_none")]
+	pub label: Option<String>,
+	#[serde(default, skip_serializing_if = "Option::is_none")]
+	pub enabled: Option<bool>,
+	#[serde(default, skip_serializing_if = "Option::is_none")]
+	pub tags: Option<Vec<String>>,
+	#[serde(default, skip_serializing_if = "Option::is_none")]
+	pub totals: Option<HashMap<String, i64>>,
+	#[serde(default, skip_serializing_if = "Option::is_none")]
+	pub primitives: Option<Primitives>,
+}
+
+impl Optionals {
+	pub fn new(count: Option<i32>, label: Option<String>, enabled: Option<bool>, tags: Option<Vec<String>>, totals: Option<HashMap<String, i64>>, primitives: Option<Primitives>) -> Self {
+		Self {
+			count,
+			label,
+			enabled,
+			tags,
+			totals,
+			primitives,
+		}
+	}
+	pub fn to_json(&self) -> Result<String> {
+		to_string(self)
+	}
+	pub fn from_json(json: &str) -> Result<Self> {
+		from_str(json)
+	}
+}
+
+#[derive(Debug, Serialize, Deserialize)]
+pub struct Empty {
+}
+
+impl Empty {
+	pub fn new() -> Self {
+		Self {
+		}
+	}
+	pub fn to_json(&self) -> Result<String> {
+		to_string(self)
+	}
+	pub fn from_json(json: &str) -> Result<Self> {
+		from_str(json)
+	}
+}
+
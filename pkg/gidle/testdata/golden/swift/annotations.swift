@@ -0,0 +1,33 @@
+import Foundation
+
+/// How an event was delivered.
+enum Channel: Int32, Codable {
+    /// Not set.
+    case NONE = 0
+    /// Sent by email.
+    case EMAIL = 1
+}
+
+/// Retry settings.
+enum Retry {
+    /// Attempts before giving up.
+    static let ATTEMPTS: Int32 = 3
+}
+
+/// An event from a third party.
+struct Event: Codable, Equatable {
+    /// Type of the event.
+    var typeName: String
+    var userId: String?
+    /// Delivery channel.
+    var channel: Channel
+    var createdAt: Int64
+
+    enum CodingKeys: String, CodingKey {
+        case typeName = "@type"
+        case userId = "user-id"
+        case channel
+        case createdAt = "created_at"
+    }
+}
+
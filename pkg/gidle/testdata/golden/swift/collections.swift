@@ -0,0 +1,41 @@
+import Foundation
+
+enum Color: UInt8, Codable {
+    case RED = 0
+    case GREEN = 1
+}
+
+struct Point: Codable, Equatable {
+    var x: Double
+    var y: Double
+
+    enum CodingKeys: String, CodingKey {
+        case x
+        case y
+    }
+}
+
+struct Shape: Codable, Equatable {
+    var points: [Point]
+    var grid: [[Int32]]
+    var named: [String: Point]
+    var palette: [String: [Color]]
+    var weights: [[String: Float]]
+    var flags: [String: [String: Bool]]
+    var counts: [[String: Int32]]
+    var aliases: [String: [String]]
+    var colors: [Color]?
+
+    enum CodingKeys: String, CodingKey {
+        case points
+        case grid
+        case named
+        case palette
+        case weights
+        case flags
+        case counts
+        case aliases
+        case colors
+    }
+}
+
@@ -0,0 +1,67 @@
+import Foundation
+
+enum Status: Int32, Codable {
+    case UNKNOWN = 0
+    case ACTIVE = 1
+    case DISABLED = 2
+}
+
+enum Size: UInt64, Codable {
+    case SMALL = 1
+    case LARGE = 1000000
+}
+
+enum Mode: String, Codable {
+    case READ = "read"
+    case WRITE = "write"
+}
+
+enum Switch: RawRepresentable, Codable {
+    case ON
+    case OFF
+
+    init?(rawValue: Bool) {
+        switch rawValue {
+        case true: self = .ON
+        case false: self = .OFF
+        }
+    }
+
+    var rawValue: Bool {
+        switch self {
+        case .ON: return true
+        case .OFF: return false
+        }
+    }
+}
+
+enum Limits {
+    static let MIN: Int64 = 0
+    static let MAX: Int64 = 10
+}
+
+enum Ratios {
+    static let HALF: Float = 0.5
+    static let ONE: Float = 1
+}
+
+enum Names {
+    static let SERVICE: String = "acme"
+}
+
+enum Flags {
+    static let ENABLED: Bool = true
+}
+
+struct Account: Codable, Equatable {
+    var status: Status
+    var mode: Mode?
+    var power: Switch
+
+    enum CodingKeys: String, CodingKey {
+        case status
+        case mode
+        case power
+    }
+}
+
@@ -0,0 +1,18 @@
+import Foundation
+
+struct Person: Codable, Equatable {
+    var name: String
+    var home: Address
+    var work: Address?
+    var kind: Kind
+    var previous: [Address]
+
+    enum CodingKeys: String, CodingKey {
+        case name
+        case home
+        case work
+        case kind
+        case previous
+    }
+}
+
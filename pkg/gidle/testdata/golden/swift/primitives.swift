@@ -0,0 +1,53 @@
+import Foundation
+
+struct Primitives: Codable, Equatable {
+    var i8: Int8
+    var i16: Int16
+    var i32: Int32
+    var i64: Int64
+    var u8: UInt8
+    var u16: UInt16
+    var u32: UInt32
+    var u64: UInt64
+    var f32: Float
+    var f64: Double
+    var flag: Bool
+    var text: String
+
+    enum CodingKeys: String, CodingKey {
+        case i8
+        case i16
+        case i32
+        case i64
+        case u8
+        case u16
+        case u32
+        case u64
+        case f32
+        case f64
+        case flag
+        case text
+    }
+}
+
+struct Optionals: Codable, Equatable {
+    var count: Int32?
+    var label: String?
+    var enabled: Bool?
+    var tags: [String]?
+    var totals: [String: Int64]?
+    var primitives: Primitives?
+
+    enum CodingKeys: String, CodingKey {
+        case count
+        case label
+        case enabled
+        case tags
+        case totals
+        case primitives
+    }
+}
+
+struct Empty: Codable, Equatable {
+}
+
@@ -0,0 +1,173 @@
+
+function gidleFail(path: string, expected: string, value: unknown): never {
+	throw new Error(`${path}: expected ${expected}, got ${JSON.stringify(value)}`);
+}
+
+function gidleDecodeString(value: unknown, path: string): string {
+	if (typeof value !== "string") {
+		return gidleFail(path, "string", value);
+	}
+	return value;
+}
+
+function gidleDecodeBoolean(value: unknown, path: string): boolean {
+	if (typeof value !== "boolean") {
+		return gidleFail(path, "boolean", value);
+	}
+	return value;
+}
+
+function gidleDecodeNumber(value: unknown, path: string): number {
+	if (typeof value !== "number") {
+		return gidleFail(path, "number", value);
+	}
+	return value;
+}
+
+function gidleDecodeInteger(value: unknown, path: string, min: number, max: number): number {
+	if (typeof value !== "number" || !Number.isInteger(value) || value < min || value > max) {
+		return gidleFail(path, `integer in [${min}, ${max}]`, value);
+	}
+	return value;
+}
+
+function gidleDecodeObject(value: unknown, path: string): Record<string, unknown> {
+	if (typeof value !== "object" || value === null || Array.isArray(value)) {
+		return gidleFail(path, "object", value);
+	}
+	return value as Record<string, unknown>;
+}
+
+function gidleDecodeArray<T>(value: unknown, path: string, decode: (value: unknown, path: string) => T): Array<T> {
+	if (!Array.isArray(value)) {
+		return gidleFail(path, "array", value);
+	}
+	return value.map((v, i) => decode(v, `${path}[${i}]`));
+}
+
+function gidleDecodeMap<K, V>(value: unknown, path: string, decodeKey: (key: string, path: string) => K, decodeValue: (value: unknown, path: string) => V): Map<K, V> {
+	const result = new Map<K, V>();
+	for (const [k, v] of Object.entries(gidleDecodeObject(value, path))) {
+		const p = `${path}[${JSON.stringify(k)}]`;
+		result.set(decodeKey(k, p), decodeValue(v, p));
+	}
+	return result;
+}
+
+function gidleDecodeRecord<K extends string | number, V>(value: unknown, path: string, decodeKey: (key: string, path: string) => K, decodeValue: (value: unknown, path: string) => V): Record<K, V> {
+	const result = {} as Record<K, V>;
+	for (const [k, v] of Object.entries(gidleDecodeObject(value, path))) {
+		const p = `${path}[${JSON.stringify(k)}]`;
+		result[decodeKey(k, p)] = decodeValue(v, p);
+	}
+	return result;
+}
+
+function gidleEncodeMap<K, V>(value: Map<K, V>, encode: (value: V) => unknown): Record<string, unknown> {
+	const result: Record<string, unknown> = {};
+	value.forEach((v, k) => {
+		result[String(k)] = encode(v);
+	});
+	return result;
+}
+
+function gidleEncodeRecord<V>(value: Record<string | number, V>, encode: (value: V) => unknown): Record<string, unknown> {
+	const result: Record<string, unknown> = {};
+	for (const [k, v] of Object.entries(value)) {
+		result[k] = encode(v);
+	}
+	return result;
+}
+
+/**
+ * How an event was delivered.
+ */
+export enum Channel {
+	/**
+	 * Not set.
+	 */
+	NONE = 0,
+	/**
+	 * Sent by email.
+	 */
+	EMAIL = 1,
+}
+
+export function indexOfChannel(value: Channel): number {
+	 switch (value) {
+		 case Channel.NONE:
+			 return 0;
+		 case Channel.EMAIL:
+			 return 1;
+		 default:
+			 return -1;
+	 }
+}
+
+export function getChannel(index: number): Channel {
+	 switch (index) {
+		 case 0:
+			 return Channel.NONE;
+		 case 1:
+			 return Channel.EMAIL;
+		 default:
+			 throw new Error("unknown enum value");
+	 }
+}
+
+export function decodeChannel(json: unknown, path: string = "$"): Channel {
+	switch (json) {
+		case 0:
+		case 1:
+			return json as Channel;
+		default:
+			return gidleFail(path, "Channel", json);
+	}
+}
+
+export function encodeChannel(value: Channel): unknown {
+	return value;
+}
+
+/**
+ * Retry settings.
+ *
+ * Attempts before giving up.
+ */
+export const Retry_ATTEMPTS = 3;
+/**
+ * An event from a third party.
+ */
+export interface Event {
+	/**
+	 * Type of the event.
+	 */
+	type_name: string;
+	user_id?: string;
+	/**
+	 * Delivery channel.
+	 */
+	channel: Channel;
+	created_at: number;
+}
+
+export function decodeEvent(json: unknown, path: string = "$"): Event {
+	const object = gidleDecodeObject(json, path);
+	return {
+		type_name: gidleDecodeString(object["@type"], path + "[\"@type\"]"),
+		user_id: object["user-id"] === undefined || object["user-id"] === null ? undefined : gidleDecodeString(object["user-id"], path + "[\"user-id\"]"),
+		channel: decodeChannel(object["channel"], `${path}.channel`),
+		created_at: gidleDecodeInteger(object["created_at"], `${path}.created_at`, Number.MIN_SAFE_INTEGER, Number.MAX_SAFE_INTEGER),
+	};
+}
+
+export function encodeEvent(value: Event): unknown {
+	return {
+		"@type": value.type_name,
+		"user-id": value.user_id,
+		channel: value.channel,
+		created_at: value.created_at,
+	};
+}
+
+
@@ -0,0 +1,281 @@
+
+function gidleFail(path: string, expected: string, value: unknown): never {
+	throw new Error(`${path}: expected ${expected}, got ${JSON.stringify(value)}`);
+}
+
+function gidleDecodeString(value: unknown, path: string): string {
+	if (typeof value !== "string") {
+		return gidleFail(path, "string", value);
+	}
+	return value;
+}
+
+function gidleDecodeBoolean(value: unknown, path: string): boolean {
+	if (typeof value !== "boolean") {
+		return gidleFail(path, "boolean", value);
+	}
+	return value;
+}
+
+function gidleDecodeNumber(value: unknown, path: string): number {
+	if (typeof value !== "number") {
+		return gidleFail(path, "number", value);
+	}
+	return value;
+}
+
+function gidleDecodeInteger(value: unknown, path: string, min: number, max: number): number {
+	if (typeof value !== "number" || !Number.isInteger(value) || value < min || value > max) {
+		return gidleFail(path, `integer in [${min}, ${max}]`, value);
+	}
+	return value;
+}
+
+function gidleDecodeObject(value: unknown, path: string): Record<string, unknown> {
+	if (typeof value !== "object" || value === null || Array.isArray(value)) {
+		return gidleFail(path, "object", value);
+	}
+	return value as Record<string, unknown>;
+}
+
+function gidleDecodeArray<T>(value: unknown, path: string, decode: (value: unknown, path: string) => T): Array<T> {
+	if (!Array.isArray(value)) {
+		return gidleFail(path, "array", value);
+	}
+	return value.map((v, i) => decode(v, `${path}[${i}]`));
+}
+
+function gidleDecodeMap<K, V>(value: unknown, path: string, decodeKey: (key: string, path: string) => K, decodeValue: (value: unknown, path: string) => V): Map<K, V> {
+	const result = new Map<K, V>();
+	for (const [k, v] of Object.entries(gidleDecodeObject(value, path))) {
+		const p = `${path}[${JSON.stringify(k)}]`;
+		result.set(decodeKey(k, p), decodeValue(v, p));
+	}
+	return result;
+}
+
+function gidleDecodeRecord<K extends string | number, V>(value: unknown, path: string, decodeKey: (key: string, path: string) => K, decodeValue: (value: unknown, path: string) => V): Record<K, V> {
+	const result = {} as Record<K, V>;
+	for (const [k, v] of Object.entries(gidleDecodeObject(value, path))) {
+		const p = `${path}[${JSON.stringify(k)}]`;
+		result[decodeKey(k, p)] = decodeValue(v, p);
+	}
+	return result;
+}
+
+function gidleEncodeMap<K, V>(value: Map<K, V>, encode: (value: V) => unknown): Record<string, unknown> {
+	const result: Record<string, unknown> = {};
+	value.forEach((v, k) => {
+		result[String(k)] = encode(v);
+	});
+	return result;
+}
+
+function gidleEncodeRecord<V>(value: Record<string | number, V>, encode: (value: V) => unknown): Record<string, unknown> {
+	const result: Record<string, unknown> = {};
+	for (const [k, v] of Object.entries(value)) {
+		result[k] = encode(v);
+	}
+	return result;
+}
+
+export enum Status {
+	UNKNOWN = 0,
+	ACTIVE = 1,
+	DISABLED = 2,
+}
+
+export function indexOfStatus(value: Status): number {
+	 switch (value) {
+		 case Status.UNKNOWN:
+			 return 0;
+		 case Status.ACTIVE:
+			 return 1;
+		 case Status.DISABLED:
+			 return 2;
+		 default:
+			 return -1;
+	 }
+}
+
+export function getStatus(index: number): Status {
+	 switch (index) {
+		 case 0:
+			 return Status.UNKNOWN;
+		 case 1:
+			 return Status.ACTIVE;
+		 case 2:
+			 return Status.DISABLED;
+		 default:
+			 throw new Error("unknown enum value");
+	 }
+}
+
+export function decodeStatus(json: unknown, path: string = "$"): Status {
+	switch (json) {
+		case 0:
+		case 1:
+		case 2:
+			return json as Status;
+		default:
+			return gidleFail(path, "Status", json);
+	}
+}
+
+export function encodeStatus(value: Status): unknown {
+	return value;
+}
+
+export enum Size {
+	SMALL = 1,
+	LARGE = 1000000,
+}
+
+export function indexOfSize(value: Size): number {
+	 switch (value) {
+		 case Size.SMALL:
+			 return 0;
+		 case Size.LARGE:
+			 return 1;
+		 default:
+			 return -1;
+	 }
+}
+
+export function getSize(index: number): Size {
+	 switch (index) {
+		 case 0:
+			 return Size.SMALL;
+		 case 1:
+			 return Size.LARGE;
+		 default:
+			 throw new Error("unknown enum value");
+	 }
+}
+
+export function decodeSize(json: unknown, path: string = "$"): Size {
+	switch (json) {
+		case 1:
+		case 1000000:
+			return json as Size;
+		default:
+			return gidleFail(path, "Size", json);
+	}
+}
+
+export function encodeSize(value: Size): unknown {
+	return value;
+}
+
+export enum Mode {
+	READ = "read",
+	WRITE = "write",
+}
+
+export function indexOfMode(value: Mode): number {
+	 switch (value) {
+		 case Mode.READ:
+			 return 0;
+		 case Mode.WRITE:
+			 return 1;
+		 default:
+			 return -1;
+	 }
+}
+
+export function getMode(index: number): Mode {
+	 switch (index) {
+		 case 0:
+			 return Mode.READ;
+		 case 1:
+			 return Mode.WRITE;
+		 default:
+			 throw new Error("unknown enum value");
+	 }
+}
+
+export function decodeMode(json: unknown, path: string = "$"): Mode {
+	switch (json) {
+		case "read":
+		case "write":
+			return json as Mode;
+		default:
+			return gidleFail(path, "Mode", json);
+	}
+}
+
+export function encodeMode(value: Mode): unknown {
+	return value;
+}
+
+export enum Switch {
+	ON = true,
+	OFF = false,
+}
+
+export function indexOfSwitch(value: Switch): number {
+	 switch (value) {
+		 case Switch.ON:
+			 return 0;
+		 case Switch.OFF:
+			 return 1;
+		 default:
+			 return -1;
+	 }
+}
+
+export function getSwitch(index: number): Switch {
+	 switch (index) {
+		 case 0:
+			 return Switch.ON;
+		 case 1:
+			 return Switch.OFF;
+		 default:
+			 throw new Error("unknown enum value");
+	 }
+}
+
+export function decodeSwitch(json: unknown, path: string = "$"): Switch {
+	switch (json) {
+		case true:
+		case false:
+			return json as Switch;
+		default:
+			return gidleFail(path, "Switch", json);
+	}
+}
+
+export function encodeSwitch(value: Switch): unknown {
+	return value;
+}
+
+export const Limits_MIN = 0;
+export const Limits_MAX = 10;
+export const Ratios_HALF = 0.5;
+export const Ratios_ONE = 1;
+export const Names_SERVICE = "acme";
+export const Flags_ENABLED = true;
+export interface Account {
+	status: Status;
+	mode?: Mode;
+	power: Switch;
+}
+
+export function decodeAccount(json: unknown, path: string = "$"): Account {
+	const object = gidleDecodeObject(json, path);
+	return {
+		status: decodeStatus(object["status"], `${path}.status`),
+		mode: object["mode"] === undefined || object["mode"] === null ? undefined : decodeMode(object["mode"], `${path}.mode`),
+		power: decodeSwitch(object["power"], `${path}.power`),
+	};
+}
+
+export function encodeAccount(value: Account): unknown {
+	return {
+		status: value.status,
+		mode: value.mode,
+		power: value.power,
+	};
+}
+
+
@@ -0,0 +1,188 @@
+export namespace acme {
+export namespace collections {
+
+function gidleFail(path: string, expected: string, value: unknown): never {
+	throw new Error(`${path}: expected ${expected}, got ${JSON.stringify(value)}`);
+}
+
+function gidleDecodeString(value: unknown, path: string): string {
+	if (typeof value !== "string") {
+		return gidleFail(path, "string", value);
+	}
+	return value;
+}
+
+function gidleDecodeBoolean(value: unknown, path: string): boolean {
+	if (typeof value !== "boolean") {
+		return gidleFail(path, "boolean", value);
+	}
+	return value;
+}
+
+function gidleDecodeNumber(value: unknown, path: string): number {
+	if (typeof value !== "number") {
+		return gidleFail(path, "number", value);
+	}
+	return value;
+}
+
+function gidleDecodeInteger(value: unknown, path: string, min: number, max: number): number {
+	if (typeof value !== "number" || !Number.isInteger(value) || value < min || value > max) {
+		return gidleFail(path, `integer in [${min}, ${max}]`, value);
+	}
+	return value;
+}
+
+function gidleDecodeObject(value: unknown, path: string): Record<string, unknown> {
+	if (typeof value !== "object" || value === null || Array.isArray(value)) {
+		return gidleFail(path, "object", value);
+	}
+	return value as Record<string, unknown>;
+}
+
+function gidleDecodeArray<T>(value: unknown, path: string, decode: (value: unknown, path: string) => T): Array<T> {
+	if (!Array.isArray(value)) {
+		return gidleFail(path, "array", value);
+	}
+	return value.map((v, i) => decode(v, `${path}[${i}]`));
+}
+
+function gidleDecodeMap<K, V>(value: unknown, path: string, decodeKey: (key: string, path: string) => K, decodeValue: (value: unknown, path: string) => V): Map<K, V> {
+	const result = new Map<K, V>();
+	for (const [k, v] of Object.entries(gidleDecodeObject(value, path))) {
+		const p = `${path}[${JSON.stringify(k)}]`;
+		result.set(decodeKey(k, p), decodeValue(v, p));
+	}
+	return result;
+}
+
+function gidleDecodeRecord<K extends string | number, V>(value: unknown, path: string, decodeKey: (key: string, path: string) => K, decodeValue: (value: unknown, path: string) => V): Record<K, V> {
+	const result = {} as Record<K, V>;
+	for (const [k, v] of Object.entries(gidleDecodeObject(value, path))) {
+		const p = `${path}[${JSON.stringify(k)}]`;
+		result[decodeKey(k, p)] = decodeValue(v, p);
+	}
+	return result;
+}
+
+function gidleEncodeMap<K, V>(value: Map<K, V>, encode: (value: V) => unknown): Record<string, unknown> {
+	const result: Record<string, unknown> = {};
+	value.forEach((v, k) => {
+		result[String(k)] = encode(v);
+	});
+	return result;
+}
+
+function gidleEncodeRecord<V>(value: Record<string | number, V>, encode: (value: V) => unknown): Record<string, unknown> {
+	const result: Record<string, unknown> = {};
+	for (const [k, v] of Object.entries(value)) {
+		result[k] = encode(v);
+	}
+	return result;
+}
+
+export enum Color {
+	RED = 0,
+	GREEN = 1,
+}
+
+export function indexOfColor(value: Color): number {
+	 switch (value) {
+		 case Color.RED:
+			 return 0;
+		 case Color.GREEN:
+			 return 1;
+		 default:
+			 return -1;
+	 }
+}
+
+export function getColor(index: number): Color {
+	 switch (index) {
+		 case 0:
+			 return Color.RED;
+		 case 1:
+			 return Color.GREEN;
+		 default:
+			 throw new Error("unknown enum value");
+	 }
+}
+
+export function decodeColor(json: unknown, path: string = "$"): Color {
+	switch (json) {
+		case 0:
+		case 1:
+			return json as Color;
+		default:
+			return gidleFail(path, "Color", json);
+	}
+}
+
+export function encodeColor(value: Color): unknown {
+	return value;
+}
+
+export interface Point {
+	x: number;
+	y: number;
+}
+
+export function decodePoint(json: unknown, path: string = "$"): Point {
+	const object = gidleDecodeObject(json, path);
+	return {
+		x: gidleDecodeNumber(object["x"], `${path}.x`),
+		y: gidleDecodeNumber(object["y"], `${path}.y`),
+	};
+}
+
+export function encodePoint(value: Point): unknown {
+	return {
+		x: value.x,
+		y: value.y,
+	};
+}
+
+export interface Shape {
+	points: Array<Point>;
+	grid: Array<Array<number>>;
+	named: Map<string,Point>;
+	palette: Map<number,Array<Color>>;
+	weights: Array<Map<string,number>>;
+	flags: Map<number,Map<string,boolean>>;
+	counts: Array<Map<string,number>>;
+	aliases: Map<string,Array<string>>;
+	colors?: Array<Color>;
+}
+
+export function decodeShape(json: unknown, path: string = "$"): Shape {
+	const object = gidleDecodeObject(json, path);
+	return {
+		points: gidleDecodeArray(object["points"], `${path}.points`, (v, p) => decodePoint(v, p)),
+		grid: gidleDecodeArray(object["grid"], `${path}.grid`, (v, p) => gidleDecodeArray(v, p, (v, p) => gidleDecodeInteger(v, p, -2147483648, 2147483647))),
+		named: gidleDecodeMap(object["named"], `${path}.named`, (k, p) => k, (v, p) => decodePoint(v, p)),
+		palette: gidleDecodeMap(object["palette"], `${path}.palette`, (k, p) => gidleDecodeInteger(Number(k), p, 0, 65535), (v, p) => gidleDecodeArray(v, p, (v, p) => decodeColor(v, p))),
+		weights: gidleDecodeArray(object["weights"], `${path}.weights`, (v, p) => gidleDecodeMap(v, p, (k, p) => k, (v, p) => gidleDecodeNumber(v, p))),
+		flags: gidleDecodeMap(object["flags"], `${path}.flags`, (k, p) => gidleDecodeInteger(Number(k), p, Number.MIN_SAFE_INTEGER, Number.MAX_SAFE_INTEGER), (v, p) => gidleDecodeMap(v, p, (k, p) => k, (v, p) => gidleDecodeBoolean(v, p))),
+		counts: gidleDecodeArray(object["counts"], `${path}.counts`, (v, p) => gidleDecodeMap(v, p, (k, p) => k, (v, p) => gidleDecodeInteger(v, p, -2147483648, 2147483647))),
+		aliases: gidleDecodeMap(object["aliases"], `${path}.aliases`, (k, p) => k, (v, p) => gidleDecodeArray(v, p, (v, p) => gidleDecodeString(v, p))),
+		colors: object["colors"] === undefined || object["colors"] === null ? undefined : gidleDecodeArray(object["colors"], `${path}.colors`, (v, p) => decodeColor(v, p)),
+	};
+}
+
+export function encodeShape(value: Shape): unknown {
+	return {
+		points: value.points.map((v) => encodePoint(v)),
+		grid: value.grid,
+		named: gidleEncodeMap(value.named, (v) => encodePoint(v)),
+		palette: gidleEncodeMap(value.palette, (v) => v),
+		weights: value.weights.map((v) => gidleEncodeMap(v, (v) => v)),
+		flags: gidleEncodeMap(value.flags, (v) => gidleEncodeMap(v, (v) => v)),
+		counts: value.counts.map((v) => gidleEncodeMap(v, (v) => v)),
+		aliases: gidleEncodeMap(value.aliases, (v) => v),
+		colors: value.colors,
+	};
+}
+
+
+}
+}
@@ -0,0 +1,118 @@
+import * as common from "./common/types";
+import * as shared from "./common/types";
+
+export namespace acme {
+export namespace people {
+
+function gidleFail(path: string, expected: string, value: unknown): never {
+	throw new Error(`${path}: expected ${expected}, got ${JSON.stringify(value)}`);
+}
+
+function gidleDecodeString(value: unknown, path: string): string {
+	if (typeof value !== "string") {
+		return gidleFail(path, "string", value);
+	}
+	return value;
+}
+
+function gidleDecodeBoolean(value: unknown, path: string): boolean {
+	if (typeof value !== "boolean") {
+		return gidleFail(path, "boolean", value);
+	}
+	return value;
+}
+
+function gidleDecodeNumber(value: unknown, path: string): number {
+	if (typeof value !== "number") {
+		return gidleFail(path, "number", value);
+	}
+	return value;
+}
+
+function gidleDecodeInteger(value: unknown, path: string, min: number, max: number): number {
+	if (typeof value !== "number" || !Number.isInteger(value) || value < min || value > max) {
+		return gidleFail(path, `integer in [${min}, ${max}]`, value);
+	}
+	return value;
+}
+
+function gidleDecodeObject(value: unknown, path: string): Record<string, unknown> {
+	if (typeof value !== "object" || value === null || Array.isArray(value)) {
+		return gidleFail(path, "object", value);
+	}
+	return value as Record<string, unknown>;
+}
+
+function gidleDecodeArray<T>(value: unknown, path: string, decode: (value: unknown, path: string) => T): Array<T> {
+	if (!Array.isArray(value)) {
+		return gidleFail(path, "array", value);
+	}
+	return value.map((v, i) => decode(v, `${path}[${i}]`));
+}
+
+function gidleDecodeMap<K, V>(value: unknown, path: string, decodeKey: (key: string, path: string) => K, decodeValue: (value: unknown, path: string) => V): Map<K, V> {
+	const result = new Map<K, V>();
+	for (const [k, v] of Object.entries(gidleDecodeObject(value, path))) {
+		const p = `${path}[${JSON.stringify(k)}]`;
+		result.set(decodeKey(k, p), decodeValue(v, p));
+	}
+	return result;
+}
+
+function gidleDecodeRecord<K extends string | number, V>(value: unknown, path: string, decodeKey: (key: string, path: string) => K, decodeValue: (value: unknown, path: string) => V): Record<K, V> {
+	const result = {} as Record<K, V>;
+	for (const [k, v] of Object.entries(gidleDecodeObject(value, path))) {
+		const p = `${path}[${JSON.stringify(k)}]`;
+		result[decodeKey(k, p)] = decodeValue(v, p);
+	}
+	return result;
+}
+
+function gidleEncodeMap<K, V>(value: Map<K, V>, encode: (value: V) => unknown): Record<string, unknown> {
+	const result: Record<string, unknown> = {};
+	value.forEach((v, k) => {
+		result[String(k)] = encode(v);
+	});
+	return result;
+}
+
+function gidleEncodeRecord<V>(value: Record<string | number, V>, encode: (value: V) => unknown): Record<string, unknown> {
+	const result: Record<string, unknown> = {};
+	for (const [k, v] of Object.entries(value)) {
+		result[k] = encode(v);
+	}
+	return result;
+}
+
+export interface Person {
+	name: string;
+	home: common.acme.common.Address;
+	work?: shared.acme.common.Address;
+	kind: common.acme.common.Kind;
+	previous: Array<common.acme.common.Address>;
+}
+
+export function decodePerson(json: unknown, path: string = "$"): Person {
+	const object = gidleDecodeObject(json, path);
+	return {
+		name: gidleDecodeString(object["name"], `${path}.name`),
+		home: common.acme.common.decodeAddress(object["home"], `${path}.home`),
+		work: object["work"] === undefined || object["work"] === null ? undefined : shared.acme.common.decodeAddress(object["work"], `${path}.work`),
+		kind: common.acme.common.decodeKind(object["kind"], `${path}.kind`),
+		previous: gidleDecodeArray(object["previous"], `${path}.previous`, (v, p) => common.acme.common.decodeAddress(v, p)),
+	};
+}
+
+export function encodePerson(value: Person): unknown {
+	return {
+		name: value.name,
+		home: common.acme.common.encodeAddress(value.home),
+		work: value.work === undefined ? undefined : shared.acme.common.encodeAddress(value.work),
+		kind: value.kind,
+		previous: value.previous.map((v) => common.acme.common.encodeAddress(v)),
+	};
+}
+
+
+}
+}
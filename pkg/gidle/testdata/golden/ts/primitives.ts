@@ -0,0 +1,184 @@
+export namespace acme {
+export namespace models {
+export namespace v1 {
+
+function gidleFail(path: string, expected: string, value: unknown): never {
+	throw new Error(`${path}: expected ${expected}, got ${JSON.stringify(value)}`);
+}
+
+function gidleDecodeString(value: unknown, path: string): string {
+	if (typeof value !== "string") {
+		return gidleFail(path, "string", value);
+	}
+	return value;
+}
+
+function gidleDecodeBoolean(value: unknown, path: string): boolean {
+	if (typeof value !== "boolean") {
+		return gidleFail(path, "boolean", value);
+	}
+	return value;
+}
+
+function gidleDecodeNumber(value: unknown, path: string): number {
+	if (typeof value !== "number") {
+		return gidleFail(path, "number", value);
+	}
+	return value;
+}
+
+function gidleDecodeInteger(value: unknown, path: string, min: number, max: number): number {
+	if (typeof value !== "number" || !Number.isInteger(value) || value < min || value > max) {
+		return gidleFail(path, `integer in [${min}, ${max}]`, value);
+	}
+	return value;
+}
+
+function gidleDecodeObject(value: unknown, path: string): Record<string, unknown> {
+	if (typeof value !== "object" || value === null || Array.isArray(value)) {
+		return gidleFail(path, "object", value);
+	}
+	return value as Record<string, unknown>;
+}
+
+function gidleDecodeArray<T>(value: unknown, path: string, decode: (value: unknown, path: string) => T): Array<T> {
+	if (!Array.isArray(value)) {
+		return gidleFail(path, "array", value);
+	}
+	return value.map((v, i) => decode(v, `${path}[${i}]`));
+}
+
+function gidleDecodeMap<K, V>(value: unknown, path: string, decodeKey: (key: string, path: string) => K, decodeValue: (value: unknown, path: string) => V): Map<K, V> {
+	const result = new Map<K, V>();
+	for (const [k, v] of Object.entries(gidleDecodeObject(value, path))) {
+		const p = `${path}[${JSON.stringify(k)}]`;
+		result.set(decodeKey(k, p), decodeValue(v, p));
+	}
+	return result;
+}
+
+function gidleDecodeRecord<K extends string | number, V>(value: unknown, path: string, decodeKey: (key: string, path: string) => K, decodeValue: (value: unknown, path: string) => V): Record<K, V> {
+	const result = {} as Record<K, V>;
+	for (const [k, v] of Object.entries(gidleDecodeObject(value, path))) {
+		const p = `${path}[${JSON.stringify(k)}]`;
+		result[decodeKey(k, p)] = decodeValue(v, p);
+	}
+	return result;
+}
+
+function gidleEncodeMap<K, V>(value: Map<K, V>, encode: (value: V) => unknown): Record<string, unknown> {
+	const result: Record<string, unknown> = {};
+	value.forEach((v, k) => {
+		result[String(k)] = encode(v);
+	});
+	return result;
+}
+
+function gidleEncodeRecord<V>(value: Record<string | number, V>, encode: (value: V) => unknown): Record<string, unknown> {
+	const result: Record<string, unknown> = {};
+	for (const [k, v] of Object.entries(value)) {
+		result[k] = encode(v);
+	}
+	return result;
+}
+
+export interface Primitives {
+	i8: number;
+	i16: number;
+	i32: number;
+	i64: number;
+	u8: number;
+	u16: number;
+	u32: number;
+	u64: number;
+	f32: number;
+	f64: number;
+	flag: boolean;
+	text: string;
+}
+
+export function decodePrimitives(json: unknown, path: string = "$"): Primitives {
+	const object = gidleDecodeObject(json, path);
+	return {
+		i8: gidleDecodeInteger(object["i8"], `${path}.i8`, -128, 127),
+		i16: gidleDecodeInteger(object["i16"], `${path}.i16`, -32768, 32767),
+		i32: gidleDecodeInteger(object["i32"], `${path}.i32`, -2147483648, 2147483647),
+		i64: gidleDecodeInteger(object["i64"], `${path}.i64`, Number.MIN_SAFE_INTEGER, Number.MAX_SAFE_INTEGER),
+		u8: gidleDecodeInteger(object["u8"], `${path}.u8`, 0, 255),
+		u16: gidleDecodeInteger(object["u16"], `${path}.u16`, 0, 65535),
+		u32: gidleDecodeInteger(object["u32"], `${path}.u32`, 0, 4294967295),
+		u64: gidleDecodeInteger(object["u64"], `${path}.u64`, 0, Number.MAX_SAFE_INTEGER),
+		f32: gidleDecodeNumber(object["f32"], `${path}.f32`),
+		f64: gidleDecodeNumber(object["f64"], `${path}.f64`),
+		flag: gidleDecodeBoolean(object["flag"], `${path}.flag`),
+		text: gidleDecodeString(object["text"], `${path}.text`),
+	};
+}
+
+export function encodePrimitives(value: Primitives): unknown {
+	return {
+		i8: value.i8,
+		i16: value.i16,
+		i32: value.i32,
+		i64: value.i64,
+		u8: value.u8,
+		u16: value.u16,
+		u32: value.u32,
+		u64: value.u64,
+		f32: value.f32,
+		f64: value.f64,
+		flag: value.flag,
+		text: value.text,
+	};
+}
+
+export interface Optionals {
+	count?: number;
+	label?: string;
+	enabled?: boolean;
+	tags?: Array<string>;
+	totals?: Map<string,number>;
+	primitives?: Primitives;
+}
+
+export function decodeOptionals(json: unknown, path: string = "$"): Optionals {
+	const object = gidleDecodeObject(json, path);
+	return {
+		count: object["count"] === undefined || object["count"] === null ? undefined : gidleDecodeInteger(object["count"], `${path}.count`, -2147483648, 2147483647),
+		label: object["label"] === undefined || object["label"] === null ? undefined : gidleDecodeString(object["label"], `${path}.label`),
+		enabled: object["enabled"] === undefined || object["enabled"] === null ? undefined : gidleDecodeBoolean(object["enabled"], `${path}.enabled`),
+		tags: object["tags"] === undefined || object["tags"] === null ? undefined : gidleDecodeArray(object["tags"], `${path}.tags`, (v, p) => gidleDecodeString(v, p)),
+		totals: object["totals"] === undefined || object["totals"] === null ? undefined : gidleDecodeMap(object["totals"], `${path}.totals`, (k, p) => k, (v, p) => gidleDecodeInteger(v, p, Number.MIN_SAFE_INTEGER, Number.MAX_SAFE_INTEGER)),
+		primitives: object["primitives"] === undefined || object["primitives"] === null ? undefined : decodePrimitives(object["primitives"], `${path}.primitives`),
+	};
+}
+
+export function encodeOptionals(value: Optionals): unknown {
+	return {
+		count: value.count,
+		label: value.label,
+		enabled: value.enabled,
+		tags: value.tags,
+		totals: value.totals === undefined ? undefined : gidleEncodeMap(value.totals, (v) => v),
+		primitives: value.primitives === undefined ? undefined : encodePrimitives(value.primitives),
+	};
+}
+
+export interface Empty {
+}
+
+export function decodeEmpty(json: unknown, path: string = "$"): Empty {
+	gidleDecodeObject(json, path);
+	return {
+	};
+}
+
+export function encodeEmpty(value: Empty): unknown {
+	return {
+	};
+}
+
+
+}
+}
+}
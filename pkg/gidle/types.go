@@ -0,0 +1,101 @@
+package gidle
+
+import (
+	"path/filepath"
+	"strings"
+)
+
+func IsPrimitiveType(t *Type) bool {
+	switch t.PrimitiveType != nil {
+	case true:
+		return true
+	default:
+		return false
+	}
+}
+
+func IsObjectType(t *Type) bool {
+	if t.PrimitiveType == nil && t.ListType == nil && t.MapType == nil {
+		return true
+	}
+
+	return false
+}
+
+func IsListType(t *Type) bool {
+	switch t.ListType != nil {
+	case true:
+		return true
+	default:
+		return false
+	}
+}
+
+func IsMapType(t *Type) bool {
+	switch t.MapType != nil {
+	case true:
+		return true
+	default:
+		return false
+	}
+}
+
+func IsOptionalField(f *ObjectField) bool {
+	return f.Optional || f.Nullable
+}
+
+const (
+	// AttributeProto sets the protobuf field number of a field.
+	AttributeProto = "proto"
+	// AttributeJSON sets the JSON key of a field.
+	AttributeJSON = "json"
+)
+
+// FieldAttribute returns the attribute of f called name, or nil.
+func FieldAttribute(f *ObjectField, name string) *Attribute {
+	for i := range f.Attributes {
+		if f.Attributes[i].Name == name {
+			return &f.Attributes[i]
+		}
+	}
+
+	return nil
+}
+
+// JSONName returns the key f is written as in JSON, its `@json` name or else its field name.
+func JSONName(f *ObjectField) string {
+	if attr := FieldAttribute(f, AttributeJSON); attr != nil && attr.Value != nil && attr.Value.StringValue != nil && *attr.Value.StringValue != "" {
+		return *attr.Value.StringValue
+	}
+
+	return f.Name
+}
+
+// SplitIdentity splits a type reference such as `common.Address` into its import
+// qualifier and name. The qualifier is empty for types declared in the same schema.
+func SplitIdentity(identity string) (qualifier string, name string) {
+	if i := strings.LastIndexByte(identity, '.'); i >= 0 {
+		return identity[:i], identity[i+1:]
+	}
+
+	return "", identity
+}
+
+// ImportQualifier returns the name an import is referred to by, its alias or
+// the last segment of the imported package.
+func ImportQualifier(imp *Import) string {
+	if imp.Alias != nil {
+		return *imp.Alias
+	}
+
+	if imp.Grammar != nil && len(imp.Grammar.Package.Names) > 0 {
+		return imp.Grammar.Package.Names[len(imp.Grammar.Package.Names)-1]
+	}
+
+	return strings.TrimSuffix(filepath.Base(imp.Path), filepath.Ext(imp.Path))
+}
+
+// IsSamePackage reports whether a and b declare the same package.
+func IsSamePackage(a, b *Grammar) bool {
+	return strings.Join(a.Package.Names, ".") == strings.Join(b.Package.Names, ".")
+}
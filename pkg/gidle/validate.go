@@ -0,0 +1,312 @@
+package gidle
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Violation is a place where a JSON document does not match a schema.
+type Violation struct {
+	// Path is the JSON pointer of the offending value, empty for the document itself.
+	Path    string
+	Message string
+}
+
+func (v *Violation) String() string {
+	if v.Path == "" {
+		return "(root): " + v.Message
+	}
+
+	return v.Path + ": " + v.Message
+}
+
+type validator struct {
+	violations []*Violation
+}
+
+// Validate checks that data is a JSON document of the object called name in values,
+// which may be qualified by an import, e.g. `common.Address`. It returns every violation
+// found, and an error when data is not JSON or name is not an object.
+func Validate(values *Grammar, name string, data []byte) ([]*Violation, error) {
+	declaring, entry := LookupEntry(values, name)
+	if entry == nil {
+		return nil, fmt.Errorf("type %s is not declared", name)
+	}
+	if entry.Object == nil {
+		return nil, fmt.Errorf("type %s is not an object", name)
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	var document any
+	if err := decoder.Decode(&document); err != nil {
+		return nil, fmt.Errorf("invalid JSON: %w", err)
+	}
+	if _, err := decoder.Token(); err == nil {
+		return nil, errors.New("invalid JSON: more than one value")
+	}
+
+	v := &validator{}
+	v.validateObject(declaring, entry.Object, "", document)
+
+	return v.violations, nil
+}
+
+func (v *validator) errorf(path string, format string, args ...any) {
+	v.violations = append(v.violations, &Violation{
+		Path:    path,
+		Message: fmt.Sprintf(format, args...),
+	})
+}
+
+func (v *validator) validateObject(values *Grammar, object *Object, path string, value any) {
+	members, ok := value.(map[string]any)
+	if !ok {
+		v.errorf(path, "expected object %s, got %s", object.Name, jsonKind(value))
+		return
+	}
+
+	known := make(map[string]bool, len(object.Fields))
+	for i := range object.Fields {
+		f := &object.Fields[i]
+		name := JSONName(f)
+		known[name] = true
+
+		member, ok := members[name]
+		switch {
+		case !ok:
+			if !IsOptionalField(f) {
+				v.errorf(path, "missing field %s", name)
+			}
+		case member == nil:
+			if !IsOptionalField(f) {
+				v.errorf(path+"/"+escapePointer(name), "field %s must not be null", name)
+			}
+		default:
+			v.validateValue(values, &f.Type, path+"/"+escapePointer(name), member)
+		}
+	}
+
+	var unknown []string
+	for name := range members {
+		if !known[name] {
+			unknown = append(unknown, name)
+		}
+	}
+	sort.Strings(unknown)
+	for _, name := range unknown {
+		v.errorf(path+"/"+escapePointer(name), "unknown field %s of object %s", name, object.Name)
+	}
+}
+
+func (v *validator) validateValue(values *Grammar, t *Type, path string, value any) {
+	switch {
+	case t.PrimitiveType != nil:
+		v.validatePrimitive(t.PrimitiveType.Type, path, value)
+	case t.ListType != nil:
+		elements, ok := value.([]any)
+		if !ok {
+			v.errorf(path, "expected %s, got %s", FormatType(t), jsonKind(value))
+			return
+		}
+		for i, element := range elements {
+			v.validateElement(values, &t.ListType.ElementType, path+"/"+strconv.Itoa(i), element)
+		}
+	case t.MapType != nil:
+		entries, ok := value.(map[string]any)
+		if !ok {
+			v.errorf(path, "expected %s, got %s", FormatType(t), jsonKind(value))
+			return
+		}
+		keys := make([]string, 0, len(entries))
+		for key := range entries {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			p := path + "/" + escapePointer(key)
+			if !checkMapKey(t.MapType.KeyType.Type, key) {
+				v.errorf(p, "map key %q is not a valid %s", key, t.MapType.KeyType.Type)
+			}
+			v.validateElement(values, &t.MapType.ValueType, p, entries[key])
+		}
+	case t.Identity != nil:
+		declaring, entry := LookupEntry(values, *t.Identity)
+		switch {
+		case entry == nil:
+			v.errorf(path, "type %s is not declared", *t.Identity)
+		case entry.Object != nil:
+			v.validateObject(declaring, entry.Object, path, value)
+		case entry.Enum != nil:
+			v.validateEnum(entry.Enum, path, value)
+		default:
+			v.errorf(path, "type %s is not an object or enum", *t.Identity)
+		}
+	}
+}
+
+// validateElement validates a list element or map value, which must not be null.
+func (v *validator) validateElement(values *Grammar, t *Type, path string, value any) {
+	if value == nil {
+		v.errorf(path, "expected %s, got null", FormatType(t))
+		return
+	}
+
+	v.validateValue(values, t, path, value)
+}
+
+func (v *validator) validatePrimitive(t string, path string, value any) {
+	switch t {
+	case "string":
+		if _, ok := value.(string); !ok {
+			v.errorf(path, "expected string, got %s", jsonKind(value))
+		}
+	case "bool":
+		if _, ok := value.(bool); !ok {
+			v.errorf(path, "expected bool, got %s", jsonKind(value))
+		}
+	case "float32", "float64":
+		number, ok := value.(json.Number)
+		if !ok {
+			v.errorf(path, "expected %s, got %s", t, jsonKind(value))
+			return
+		}
+		if f, err := number.Float64(); err != nil || t == "float32" && math.Abs(f) > math.MaxFloat32 {
+			v.errorf(path, "%s is out of range for %s", number, t)
+		}
+	default:
+		number, ok := value.(json.Number)
+		if !ok {
+			v.errorf(path, "expected %s, got %s", t, jsonKind(value))
+			return
+		}
+		if message := checkInteger(t, number.String()); message != "" {
+			v.errorf(path, "%s", message)
+		}
+	}
+}
+
+func (v *validator) validateEnum(enum *Enum, path string, value any) {
+	var written string
+	ok := false
+	switch value := value.(type) {
+	case string:
+		written, ok = value, enum.Type.Type == "string"
+	case bool:
+		written, ok = strconv.FormatBool(value), enum.Type.Type == "bool"
+	case json.Number:
+		written, ok = value.String(), enum.Type.Type != "string" && enum.Type.Type != "bool"
+	}
+	if !ok {
+		v.errorf(path, "expected enum %s of %s, got %s", enum.Name, enum.Type.Type, jsonKind(value))
+		return
+	}
+
+	for i := range enum.Body {
+		if enumValueIs(enum.Type.Type, &enum.Body[i].Value, value, written) {
+			return
+		}
+	}
+
+	names := make([]string, 0, len(enum.Body))
+	for i := range enum.Body {
+		names = append(names, diffValue(&enum.Body[i].Value))
+	}
+	if enum.Type.Type == "string" {
+		written = strconv.Quote(written)
+	}
+	v.errorf(path, "%s is not a value of enum %s (%s)", written, enum.Name, strings.Join(names, ", "))
+}
+
+// enumValueIs reports whether the JSON value, written as written, is the enum member value of
+// an enum of type t. Numbers are compared by value, so 1.0 is the float enum member 1.
+func enumValueIs(t string, member *PrimitiveValue, value any, written string) bool {
+	number, ok := value.(json.Number)
+	if !ok {
+		return primitiveValueString(member) == written
+	}
+
+	switch t {
+	case "float32", "float64":
+		f, err := number.Float64()
+		if err != nil {
+			return false
+		}
+		if member.IntValue != nil {
+			return f == float64(*member.IntValue)
+		}
+		return member.FloatValue != nil && f == *member.FloatValue
+	default:
+		n, err := strconv.ParseInt(number.String(), 10, 64)
+		return err == nil && member.IntValue != nil && n == *member.IntValue
+	}
+}
+
+// checkInteger returns why s is not an integer of type t, or "" if it is.
+func checkInteger(t string, s string) string {
+	min, max := integerRange(t)
+	if strings.HasPrefix(s, "-") {
+		n, err := strconv.ParseInt(s, 10, 64)
+		if err == nil && n >= min {
+			return ""
+		}
+		if err != nil && !errors.Is(err, strconv.ErrRange) {
+			return s + " is not an integer"
+		}
+	} else {
+		n, err := strconv.ParseUint(s, 10, 64)
+		if err == nil && n <= max {
+			return ""
+		}
+		if err != nil && !errors.Is(err, strconv.ErrRange) {
+			return s + " is not an integer"
+		}
+	}
+
+	return fmt.Sprintf("%s is out of range for %s (%d..%d)", s, t, min, max)
+}
+
+// checkMapKey reports whether key, a JSON object key, is a map key of type t.
+func checkMapKey(t string, key string) bool {
+	switch t {
+	case "string":
+		return true
+	case "bool":
+		return key == "true" || key == "false"
+	case "float32", "float64":
+		_, err := strconv.ParseFloat(key, 64)
+		return err == nil
+	default:
+		return checkInteger(t, key) == ""
+	}
+}
+
+func jsonKind(value any) string {
+	switch value.(type) {
+	case nil:
+		return "null"
+	case bool:
+		return "bool"
+	case json.Number:
+		return "number"
+	case string:
+		return "string"
+	case []any:
+		return "array"
+	case map[string]any:
+		return "object"
+	default:
+		return fmt.Sprintf("%T", value)
+	}
+}
+
+// escapePointer escapes a key for a JSON pointer as described in RFC 6901.
+func escapePointer(key string) string {
+	return strings.ReplaceAll(strings.ReplaceAll(key, "~", "~0"), "/", "~1")
+}
@@ -0,0 +1,60 @@
+package gidle
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// TestValidate validates every testdata/validate/*.json document against the Person object of
+// testdata/validate/person.gidle and compares the violations with <document>.golden.
+func TestValidate(t *testing.T) {
+	root := filepath.Join("testdata", "validate")
+	schema, err := ParseFile(filepath.Join(root, "person.gidle"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	documents, err := filepath.Glob(filepath.Join(root, "*.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, document := range documents {
+		t.Run(filepath.Base(document), func(t *testing.T) {
+			data, err := os.ReadFile(document)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			violations, err := Validate(schema, "Person", data)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var sb strings.Builder
+			for _, v := range violations {
+				sb.WriteString(v.String())
+				sb.WriteString("\n")
+			}
+			got := sb.String()
+
+			golden := strings.TrimSuffix(document, ".json") + ".golden"
+			if *update {
+				if err := os.WriteFile(golden, []byte(got), 0644); err != nil {
+					t.Fatal(err)
+				}
+				return
+			}
+
+			want, err := os.ReadFile(golden)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != string(want) {
+				t.Errorf("violations differ from %s:\n%s", golden, diffLines(string(want), got))
+			}
+		})
+	}
+}
@@ -0,0 +1,104 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/snowmerak/gidle/pkg/gidle"
+)
+
+// ValidateViolation is a violation of `gidle validate -format json`.
+type ValidateViolation struct {
+	File    string `json:"file"`
+	Path    string `json:"path"`
+	Message string `json:"message"`
+}
+
+// validateCommand checks JSON documents, or stdin without files, against an object of a schema
+// and exits with ExitCheckFailed if any of them does not match.
+func validateCommand(args []string) int {
+	flags := flag.NewFlagSet("gidle "+CommandValidate, flag.ContinueOnError)
+	flags.Usage = func() {
+		fmt.Fprintf(flags.Output(), "Usage: gidle %s -i <input> -t <object> [<json file>...]\n\n", CommandValidate)
+		flags.PrintDefaults()
+	}
+	inputFile := flags.String("i", "", "input file")
+	typeName := flags.String("t", "", "object the documents are validated against, e.g. Person or common.Address")
+	format := flags.String("format", DiagnosticFormatText, "output format of violations and diagnostics (text or json)")
+	if err := flags.Parse(args); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return ExitOK
+		}
+		return ExitUsage
+	}
+
+	reporter, diagnostic := newReporter(*format)
+	if diagnostic != nil {
+		return reporter.Report(diagnostic)
+	}
+
+	if *inputFile == "" || *typeName == "" {
+		flags.Usage()
+		return reporter.Report(&Diagnostic{Kind: DiagnosticUsage, Message: "-i and -t are required"})
+	}
+
+	values, diagnostics := compile(reporter, *inputFile)
+	if len(diagnostics) > 0 {
+		return reporter.Report(diagnostics...)
+	}
+
+	if _, entry := gidle.LookupEntry(values, *typeName); entry == nil || entry.Object == nil {
+		return reporter.Report(&Diagnostic{Kind: DiagnosticUsage, Message: "-t: " + *typeName + " is not an object of " + *inputFile})
+	}
+
+	violations := []ValidateViolation{}
+	validate := func(file string, data []byte) {
+		found, err := gidle.Validate(values, *typeName, data)
+		if err != nil {
+			diagnostics = append(diagnostics, &Diagnostic{Kind: DiagnosticParse, File: file, Message: err.Error()})
+			return
+		}
+		for _, v := range found {
+			violations = append(violations, ValidateViolation{File: file, Path: v.Path, Message: v.Message})
+			if *format != DiagnosticFormatJSON {
+				fmt.Println(file + ": " + v.String())
+			}
+		}
+	}
+
+	if flags.NArg() == 0 {
+		data, err := io.ReadAll(os.Stdin)
+		if err != nil {
+			return reporter.Report(DiagnosticsFromError(DiagnosticIO, err)...)
+		}
+		validate("<stdin>", data)
+	}
+	for _, file := range flags.Args() {
+		data, err := os.ReadFile(file)
+		if err != nil {
+			diagnostics = append(diagnostics, DiagnosticsFromError(DiagnosticIO, err)...)
+			continue
+		}
+		validate(file, data)
+	}
+
+	if *format == DiagnosticFormatJSON {
+		encoder := json.NewEncoder(os.Stdout)
+		encoder.SetIndent("", "  ")
+		encoder.Encode(violations)
+	}
+
+	if len(diagnostics) > 0 {
+		return reporter.Report(diagnostics...)
+	}
+
+	if len(violations) > 0 {
+		return ExitCheckFailed
+	}
+
+	return ExitOK
+}